@@ -33,6 +33,94 @@ func Merge[T any](ctx context.Context, chans ...<-chan T) <-chan T {
 	return outChan
 }
 
+// MergePriority combines a high-priority and a low-priority channel into a single output channel.
+// Whenever both have a value ready, the high-priority value is forwarded first, so control
+// messages are never starved by a busy data channel.
+// The output channel closes when both inputs have closed or the context is canceled.
+func MergePriority[T any](ctx context.Context, high, low <-chan T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		for high != nil || low != nil {
+			var val T
+			var ok bool
+			fromHigh := false
+
+			// Check high first; a nil high channel is never ready and falls through to the default.
+			select {
+			case val, ok = <-high:
+				fromHigh = true
+			default:
+				select {
+				case <-ctx.Done():
+					return
+				case val, ok = <-high:
+					fromHigh = true
+				case val, ok = <-low:
+				}
+			}
+
+			if !ok {
+				if fromHigh {
+					high = nil
+				} else {
+					low = nil
+				}
+				continue
+			}
+
+			if !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// FirstReady races the given channels and returns the first value received, the index of
+// the channel it came from, and true. Channels that close without producing a value are
+// dropped from the race. The losing channels are drained in the background so their
+// producers are not blocked; the winning channel is left for the caller.
+// It returns false if every channel closes empty or the context is canceled first.
+func FirstReady[T any](ctx context.Context, chans ...<-chan T) (T, int, bool) {
+	var zero T
+
+	cases := make([]reflect.SelectCase, 0, len(chans)+1)
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+	for _, ch := range chans {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)})
+	}
+
+	for remaining := len(chans); remaining > 0; {
+		chosen, val, ok := reflect.Select(cases)
+		if chosen == 0 {
+			return zero, -1, false
+		}
+
+		if !ok {
+			// A zero Value channel is never ready, which removes it from the race.
+			cases[chosen].Chan = reflect.Value{}
+			remaining--
+			continue
+		}
+
+		index := chosen - 1
+		for i, ch := range chans {
+			if i != index {
+				go drain(ch)
+			}
+		}
+		// The comma-ok form yields the zero T for a nil interface value.
+		v, _ := val.Interface().(T)
+		return v, index, true
+	}
+
+	return zero, -1, false
+}
+
 // Zip combines two channels into a single channel of paired values.
 // It stops when either channel closes or context is canceled.
 func Zip[T, R any](ctx context.Context, ch1 <-chan T, ch2 <-chan R) <-chan struct {
@@ -71,6 +159,123 @@ func Zip[T, R any](ctx context.Context, ch1 <-chan T, ch2 <-chan R) <-chan struc
 	return outChan
 }
 
+// Zip3 combines three channels into a single channel of typed triples.
+// It stops when any channel closes or context is canceled.
+func Zip3[A, B, C any](ctx context.Context, a <-chan A, b <-chan B, c <-chan C) <-chan struct {
+	First  A
+	Second B
+	Third  C
+} {
+	outChan := make(chan struct {
+		First  A
+		Second B
+		Third  C
+	})
+
+	go func() {
+		defer close(outChan)
+		for {
+			val1, ok1 := recieve(ctx, a)
+			if !ok1 {
+				return // First channel closed
+			}
+
+			val2, ok2 := recieve(ctx, b)
+			if !ok2 {
+				return // Second channel closed
+			}
+
+			val3, ok3 := recieve(ctx, c)
+			if !ok3 {
+				return // Third channel closed
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case outChan <- struct {
+				First  A
+				Second B
+				Third  C
+			}{First: val1, Second: val2, Third: val3}:
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// ZipLongest combines two channels into a single channel of paired values,
+// continuing until both channels have closed. Once a channel has closed,
+// the provided default is substituted for its side of each pair.
+// It stops when both channels close or context is canceled.
+//
+// Example:
+//
+//	ch1 := chankit.SliceToChan(ctx, []int{1, 2, 3})
+//	ch2 := chankit.SliceToChan(ctx, []string{"a"})
+//	zipped := chankit.ZipLongest(ctx, ch1, ch2, 0, "")
+//	// Output: {1, "a"}, {2, ""}, {3, ""}
+func ZipLongest[A, B any](ctx context.Context, a <-chan A, b <-chan B, defA A, defB B, opts ...ChanOption[struct {
+	First  A
+	Second B
+}]) <-chan struct {
+	First  A
+	Second B
+} {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		aOpen, bOpen := true, true
+
+		for {
+			val1, val2 := defA, defB
+
+			if aOpen {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-a:
+					if ok {
+						val1 = v
+					} else {
+						aOpen = false
+					}
+				}
+			}
+
+			if bOpen {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-b:
+					if ok {
+						val2 = v
+					} else {
+						bOpen = false
+					}
+				}
+			}
+
+			if !aOpen && !bOpen {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case outChan <- struct {
+				First  A
+				Second B
+			}{First: val1, Second: val2}:
+			}
+		}
+	}()
+
+	return outChan
+}
+
 // ZipN combines multiple channels into a single channel of slices.
 // It reads one value from each channel and emits them as a slice.
 // It stops when any channel closes or context is canceled.
@@ -118,6 +323,48 @@ func ZipN(ctx context.Context, channels ...any) <-chan []any {
 	return outChan
 }
 
+// Barrier waits until every channel has produced one new value, emits that round as a
+// slice in channel order, and starts the next round. It is the homogeneous, type-safe
+// counterpart of ZipN and uses no reflection. A fresh slice is allocated for every round.
+// It stops when any channel closes or context is canceled; a partial round is discarded.
+//
+// Example:
+//
+//	a := chankit.SliceToChan(ctx, []int{1, 2})
+//	b := chankit.SliceToChan(ctx, []int{10, 20})
+//	c := chankit.SliceToChan(ctx, []int{100, 200})
+//	rounds := chankit.Barrier(ctx, a, b, c)
+//	// Output: [][]int{{1, 10, 100}, {2, 20, 200}}
+func Barrier[T any](ctx context.Context, chans ...<-chan T) <-chan []T {
+	outChan := make(chan []T)
+
+	if len(chans) == 0 {
+		close(outChan)
+		return outChan
+	}
+
+	go func() {
+		defer close(outChan)
+
+		for {
+			round := make([]T, len(chans))
+			for i, ch := range chans {
+				val, ok := recieve(ctx, ch)
+				if !ok {
+					return
+				}
+				round[i] = val
+			}
+
+			if !send(ctx, outChan, round) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
 // receiveFromChannel is a helper that uses reflection to receive from any channel type
 func receiveFromChannel(ch any) (any, bool) {
 	val := reflect.ValueOf(ch)
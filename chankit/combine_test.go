@@ -145,6 +145,7 @@ func TestMerge(t *testing.T) {
 
 	t.Run("context cancellation stops merge", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		ch1 := make(chan int)
 		ch2 := make(chan int)
 
@@ -412,6 +413,142 @@ func TestMerge(t *testing.T) {
 }
 
 // TestZip tests the Zip function
+func TestMergePriority(t *testing.T) {
+	t.Run("ready high-priority values win", func(t *testing.T) {
+		ctx := context.Background()
+		high := make(chan string, 2)
+		low := make(chan string, 2)
+
+		low <- "data-1"
+		low <- "data-2"
+		high <- "ctrl-1"
+		high <- "ctrl-2"
+		close(high)
+		close(low)
+
+		var result []string
+		for v := range MergePriority(ctx, high, low) {
+			result = append(result, v)
+		}
+
+		expected := []string{"ctrl-1", "ctrl-2", "data-1", "data-2"}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("forwards low values while high is idle", func(t *testing.T) {
+		ctx := context.Background()
+		high := make(chan int)
+		defer close(high)
+
+		out := MergePriority(ctx, high, SliceToChan(ctx, []int{1, 2, 3}))
+
+		for i := 1; i <= 3; i++ {
+			select {
+			case v := <-out:
+				if v != i {
+					t.Errorf("expected %d, got %d", i, v)
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatal("timed out waiting for low-priority value")
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := MergePriority(ctx, make(chan int), make(chan int))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestFirstReady(t *testing.T) {
+	t.Run("returns the quickest channel", func(t *testing.T) {
+		ctx := context.Background()
+		slow1 := make(chan string)
+		fast := make(chan string)
+		slow2 := make(chan string)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			fast <- "fast"
+		}()
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			slow1 <- "slow"
+			close(slow1)
+		}()
+
+		val, idx, ok := FirstReady(ctx, slow1, fast, slow2)
+		if !ok || idx != 1 || val != "fast" {
+			t.Errorf("expected (fast, 1, true), got (%s, %d, %v)", val, idx, ok)
+		}
+		close(slow2)
+	})
+
+	t.Run("skips channels that close empty", func(t *testing.T) {
+		ctx := context.Background()
+		empty := make(chan int)
+		close(empty)
+		later := make(chan int, 1)
+		later <- 42
+
+		val, idx, ok := FirstReady(ctx, empty, later)
+		if !ok || idx != 1 || val != 42 {
+			t.Errorf("expected (42, 1, true), got (%d, %d, %v)", val, idx, ok)
+		}
+	})
+
+	t.Run("nil interface value wins", func(t *testing.T) {
+		ctx := context.Background()
+		idle := make(chan error)
+		winner := make(chan error, 1)
+		winner <- nil
+
+		val, idx, ok := FirstReady(ctx, idle, winner)
+		if !ok || idx != 1 || val != nil {
+			t.Errorf("expected (<nil>, 1, true), got (%v, %d, %v)", val, idx, ok)
+		}
+		close(idle)
+	})
+
+	t.Run("all channels closed", func(t *testing.T) {
+		ctx := context.Background()
+		a, b := make(chan int), make(chan int)
+		close(a)
+		close(b)
+
+		if _, idx, ok := FirstReady(ctx, a, b); ok || idx != -1 {
+			t.Errorf("expected (-1, false), got (%d, %v)", idx, ok)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+
+		if _, idx, ok := FirstReady(ctx, make(chan int), make(chan int)); ok || idx != -1 {
+			t.Errorf("expected (-1, false), got (%d, %v)", idx, ok)
+		}
+	})
+}
+
 func TestZip(t *testing.T) {
 	t.Run("zips values from two channels", func(t *testing.T) {
 		ctx := context.Background()
@@ -556,6 +693,7 @@ func TestZip(t *testing.T) {
 
 	t.Run("context cancellation stops zip", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		ch1 := make(chan int)
 		ch2 := make(chan string)
 
@@ -916,6 +1054,153 @@ func TestZip(t *testing.T) {
 	})
 }
 
+// TestZipLongest tests the ZipLongest function
+func TestZip3(t *testing.T) {
+	type triple = struct {
+		First  int
+		Second string
+		Third  bool
+	}
+
+	t.Run("zips values from three channels", func(t *testing.T) {
+		ctx := context.Background()
+		a := SliceToChan(ctx, []int{1, 2, 3})
+		b := SliceToChan(ctx, []string{"a", "b", "c"})
+		c := SliceToChan(ctx, []bool{true, false, true})
+
+		var results []triple
+		for val := range Zip3(ctx, a, b, c) {
+			results = append(results, val)
+		}
+
+		expected := []triple{{1, "a", true}, {2, "b", false}, {3, "c", true}}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d triples, got %d", len(expected), len(results))
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %+v, got %+v", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("stops when the shortest channel closes", func(t *testing.T) {
+		ctx := context.Background()
+		a := SliceToChan(ctx, []int{1, 2, 3})
+		b := SliceToChan(ctx, []string{"a", "b", "c"})
+		c := SliceToChan(ctx, []bool{true})
+
+		count := 0
+		for range Zip3(ctx, a, b, c) {
+			count++
+		}
+
+		if count != 1 {
+			t.Errorf("expected 1 triple, got %d", count)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Zip3(ctx, make(chan int), make(chan string), make(chan bool))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestZipLongest(t *testing.T) {
+	type pair = struct {
+		First  int
+		Second string
+	}
+
+	t.Run("pads the shorter stream with defaults", func(t *testing.T) {
+		ctx := context.Background()
+		ch1 := SliceToChan(ctx, []int{1, 2, 3})
+		ch2 := SliceToChan(ctx, []string{"a"})
+
+		var results []pair
+		for val := range ZipLongest(ctx, ch1, ch2, 0, "") {
+			results = append(results, val)
+		}
+
+		expected := []pair{{1, "a"}, {2, ""}, {3, ""}}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d pairs, got %d", len(expected), len(results))
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %+v, got %+v", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("pads the first stream when it is shorter", func(t *testing.T) {
+		ctx := context.Background()
+		ch1 := SliceToChan(ctx, []int{1})
+		ch2 := SliceToChan(ctx, []string{"a", "b"})
+
+		var results []pair
+		for val := range ZipLongest(ctx, ch1, ch2, -1, "") {
+			results = append(results, val)
+		}
+
+		expected := []pair{{1, "a"}, {-1, "b"}}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d pairs, got %d", len(expected), len(results))
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %+v, got %+v", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("both channels empty", func(t *testing.T) {
+		ctx := context.Background()
+		ch1 := make(chan int)
+		ch2 := make(chan string)
+		close(ch1)
+		close(ch2)
+
+		count := 0
+		for range ZipLongest(ctx, ch1, ch2, 0, "") {
+			count++
+		}
+
+		if count != 0 {
+			t.Errorf("expected 0 pairs, got %d", count)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch1 := make(chan int)
+		ch2 := make(chan string)
+		out := ZipLongest(ctx, ch1, ch2, 0, "")
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
 // TestZipN tests the ZipN function
 func TestZipN(t *testing.T) {
 	t.Run("zips three channels", func(t *testing.T) {
@@ -1039,6 +1324,7 @@ func TestZipN(t *testing.T) {
 
 	t.Run("context cancellation stops zipN", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		ch1 := make(chan int)
 		ch2 := make(chan string)
 		ch3 := make(chan bool)
@@ -1170,3 +1456,76 @@ func TestZipN(t *testing.T) {
 		}
 	})
 }
+
+func TestBarrier(t *testing.T) {
+	t.Run("emits one value per channel each round", func(t *testing.T) {
+		ctx := context.Background()
+		a := SliceToChan(ctx, []int{1, 2, 3})
+		b := SliceToChan(ctx, []int{10, 20, 30})
+		c := SliceToChan(ctx, []int{100, 200})
+
+		var results [][]int
+		for round := range Barrier(ctx, a, b, c) {
+			results = append(results, round)
+		}
+
+		expected := [][]int{{1, 10, 100}, {2, 20, 200}}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d rounds, got %d: %v", len(expected), len(results), results)
+		}
+		for i, round := range results {
+			for j, v := range round {
+				if v != expected[i][j] {
+					t.Errorf("round %d, channel %d: expected %d, got %d", i, j, expected[i][j], v)
+				}
+			}
+		}
+	})
+
+	t.Run("waits for the slowest channel", func(t *testing.T) {
+		ctx := context.Background()
+		fast := SliceToChan(ctx, []int{1, 2})
+		slow := make(chan int)
+
+		go func() {
+			defer close(slow)
+			for _, v := range []int{10, 20} {
+				time.Sleep(20 * time.Millisecond)
+				slow <- v
+			}
+		}()
+
+		var results [][]int
+		for round := range Barrier(ctx, fast, slow) {
+			results = append(results, round)
+		}
+
+		if len(results) != 2 || results[0][1] != 10 || results[1][1] != 20 {
+			t.Errorf("expected rounds [[1 10] [2 20]], got %v", results)
+		}
+	})
+
+	t.Run("no channels", func(t *testing.T) {
+		ctx := context.Background()
+
+		if _, ok := <-Barrier[int](ctx); ok {
+			t.Error("expected closed channel")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Barrier(ctx, make(chan int), make(chan int))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
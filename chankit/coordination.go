@@ -0,0 +1,55 @@
+package chankit
+
+import (
+	"context"
+	"reflect"
+)
+
+// WaitAll blocks until every provided done channel has closed or the context is cancelled.
+// It returns nil when all channels closed, or the context error if cancellation came first.
+// Calling it with no channels returns nil immediately.
+//
+// Example:
+//
+//	d1 := pipeline1.RunAsync(handle)
+//	d2 := pipeline2.RunAsync(handle)
+//	if err := WaitAll(ctx, d1, d2); err != nil {
+//		log.Println("gave up waiting:", err)
+//	}
+func WaitAll(ctx context.Context, dones ...<-chan struct{}) error {
+	for _, done := range dones {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-done:
+		}
+	}
+	return nil
+}
+
+// WaitAny blocks until the first of the provided done channels closes or the context is cancelled.
+// It returns the index of the channel that closed first, or -1 together with the context
+// error if cancellation came first. Calling it with no channels returns -1 and nil immediately.
+//
+// Example:
+//
+//	d1 := primary.RunAsync(handle)
+//	d2 := fallback.RunAsync(handle)
+//	idx, err := WaitAny(ctx, d1, d2) // idx is 0 or 1
+func WaitAny(ctx context.Context, dones ...<-chan struct{}) (int, error) {
+	if len(dones) == 0 {
+		return -1, nil
+	}
+
+	cases := make([]reflect.SelectCase, 0, len(dones)+1)
+	for _, done := range dones {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(done)})
+	}
+	cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())})
+
+	chosen, _, _ := reflect.Select(cases)
+	if chosen == len(dones) {
+		return -1, ctx.Err()
+	}
+	return chosen, nil
+}
@@ -0,0 +1,90 @@
+package chankit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestWaitAll tests the WaitAll function
+func TestWaitAll(t *testing.T) {
+	t.Run("waits for all async pipelines", func(t *testing.T) {
+		ctx := context.Background()
+		var total atomic.Int64
+
+		d1 := FromSlice(ctx, []int{1, 2, 3}).RunAsync(func(x int) { total.Add(int64(x)) })
+		d2 := FromSlice(ctx, []int{10, 20}).Tap(func(int) { time.Sleep(10 * time.Millisecond) }).RunAsync(func(x int) { total.Add(int64(x)) })
+		d3 := FromSlice(ctx, []int{100}).RunAsync(func(x int) { total.Add(int64(x)) })
+
+		if err := WaitAll(ctx, d1, d2, d3); err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+
+		if got := total.Load(); got != 136 {
+			t.Errorf("expected total 136, got %d", got)
+		}
+	})
+
+	t.Run("no channels returns immediately", func(t *testing.T) {
+		if err := WaitAll(context.Background()); err != nil {
+			t.Errorf("expected nil error, got %v", err)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		closed := make(chan struct{})
+		close(closed)
+		never := make(chan struct{})
+
+		err := WaitAll(ctx, closed, never)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected deadline exceeded, got %v", err)
+		}
+	})
+}
+
+// TestWaitAny tests the WaitAny function
+func TestWaitAny(t *testing.T) {
+	t.Run("returns first completed pipeline", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		slow := NewPipeline[int](ctx).Repeat(1).Throttle(10 * time.Millisecond).RunAsync(func(int) {})
+		fast := FromSlice(ctx, []int{1, 2, 3}).RunAsync(func(int) {})
+
+		idx, err := WaitAny(ctx, slow, fast)
+		if err != nil {
+			t.Fatalf("expected nil error, got %v", err)
+		}
+		if idx != 1 {
+			t.Errorf("expected index 1, got %d", idx)
+		}
+	})
+
+	t.Run("no channels returns immediately", func(t *testing.T) {
+		idx, err := WaitAny(context.Background())
+		if idx != -1 || err != nil {
+			t.Errorf("expected (-1, nil), got (%d, %v)", idx, err)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		never := make(chan struct{})
+
+		go func() {
+			time.Sleep(20 * time.Millisecond)
+			cancel()
+		}()
+
+		idx, err := WaitAny(ctx, never)
+		if idx != -1 || !errors.Is(err, context.Canceled) {
+			t.Errorf("expected (-1, context.Canceled), got (%d, %v)", idx, err)
+		}
+	})
+}
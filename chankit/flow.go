@@ -2,11 +2,16 @@ package chankit
 
 import (
 	"context"
+	"math"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Throttle limits the rate of values emitted from a channel by dropping intermediate values.
 // Only the most recent value received within each time interval is emitted.
+// If the input closes while a value is still pending, that value is emitted before
+// the output channel closes.
 // This is useful for UI updates, event debouncing, or reducing high-frequency data streams.
 //
 // Example:
@@ -15,6 +20,56 @@ import (
 //	Duration: 100ms
 //	Output: [5] (at 100ms) - values 1-4 were dropped
 func Throttle[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) <-chan T {
+	return throttle(ctx, in, d, nil, opts...)
+}
+
+// FlowStats holds counters for a flow-control operator. The fields are updated atomically
+// by the operator goroutine and can be read concurrently at any time.
+// Once the output channel has closed, Received == Emitted + Dropped.
+type FlowStats struct {
+	Received atomic.Int64
+	Emitted  atomic.Int64
+	Dropped  atomic.Int64
+}
+
+// countReceived, countEmitted and countDropped bump the matching counter.
+// They are no-ops on a nil FlowStats, so operators can count unconditionally.
+func (s *FlowStats) countReceived() {
+	if s != nil {
+		s.Received.Add(1)
+	}
+}
+
+func (s *FlowStats) countEmitted() {
+	if s != nil {
+		s.Emitted.Add(1)
+	}
+}
+
+func (s *FlowStats) countDropped() {
+	if s != nil {
+		s.Dropped.Add(1)
+	}
+}
+
+// ThrottleWithStats behaves like Throttle and additionally returns a FlowStats that counts
+// received, emitted and dropped values. A value superseded by a newer one within the same
+// interval, or still pending when the context is cancelled, is counted as dropped.
+//
+// Example:
+//
+//	out, stats := ThrottleWithStats(ctx, events, 100*time.Millisecond)
+//	for v := range out {
+//		handle(v)
+//	}
+//	log.Printf("dropped %d of %d", stats.Dropped.Load(), stats.Received.Load())
+func ThrottleWithStats[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) (<-chan T, *FlowStats) {
+	stats := &FlowStats{}
+	return throttle(ctx, in, d, stats, opts...), stats
+}
+
+// throttle implements Throttle and ThrottleWithStats. A nil stats disables counting.
+func throttle[T any](ctx context.Context, in <-chan T, d time.Duration, stats *FlowStats, opts ...ChanOption[T]) <-chan T {
 	outChan := applyChanOptions(opts...)
 
 	go func() {
@@ -23,27 +78,157 @@ func Throttle[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...
 		defer ticker.Stop()
 
 		var pending *T
+		emit := func() bool {
+			if !send(ctx, outChan, *pending) {
+				stats.countDropped()
+				return false
+			}
+			stats.countEmitted()
+			pending = nil
+			return true
+		}
 
 		for {
 			select {
 			case <-ctx.Done():
+				if pending != nil {
+					stats.countDropped()
+				}
 				return
 
 			case val, ok := <-in:
 				if !ok {
+					if pending != nil {
+						emit()
+					}
 					return
 				}
+				stats.countReceived()
+				if pending != nil {
+					stats.countDropped()
+				}
 				pending = &val
 
 			case <-ticker.C:
-				if pending != nil {
-					select {
-					case <-ctx.Done():
-						return
-					case outChan <- *pending:
-						pending = nil
+				if pending != nil && !emit() {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// ThrottleFlushable behaves like Throttle, but also emits the pending value as soon as flush
+// signals, without waiting for the next tick. Each flush restarts the interval, so the next
+// regular emission happens a full d later. A flush with nothing pending is a no-op, and a
+// closed flush channel is ignored from then on.
+//
+// Example:
+//
+//	Input:    [1, 2] at 0ms, flush at 30ms
+//	Duration: 100ms
+//	Output:   [2] (at 30ms, instead of 100ms)
+func ThrottleFlushable[T any](ctx context.Context, in <-chan T, d time.Duration, flush <-chan struct{}, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		var pending *T
+		emit := func() bool {
+			if pending == nil {
+				return true
+			}
+			if !send(ctx, outChan, *pending) {
+				return false
+			}
+			pending = nil
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					emit()
+					return
+				}
+				pending = &val
+
+			case _, ok := <-flush:
+				if !ok {
+					flush = nil
+					continue
+				}
+				if !emit() {
+					return
+				}
+				ticker.Reset(d)
+
+			case <-ticker.C:
+				if !emit() {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// ThrottleByKey applies an independent leading-edge throttle to each key derived by keyFn.
+// A value is emitted only if at least d has passed since the previous emission for its key;
+// otherwise it is dropped. Keys that have been quiet for d are evicted on a periodic sweep,
+// since their next value would pass anyway, so memory stays bounded by the number of recently active keys.
+//
+// Example:
+//
+//	Input:    [a1, b1, a2, b2] within 10ms, then [a3] at 150ms
+//	KeyFn:    first letter, Duration: 100ms
+//	Output:   [a1, b1] (immediately), [a3] (at 150ms) - a2 and b2 were dropped
+func ThrottleByKey[T any, K comparable](ctx context.Context, in <-chan T, keyFn func(T) K, d time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		sweep := time.NewTicker(d)
+		defer sweep.Stop()
+
+		lastEmit := make(map[K]time.Time)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case now := <-sweep.C:
+				for key, at := range lastEmit {
+					if now.Sub(at) >= d {
+						delete(lastEmit, key)
 					}
 				}
+
+			case val, ok := <-in:
+				if !ok {
+					return
+				}
+
+				key, now := keyFn(val), time.Now()
+				if at, seen := lastEmit[key]; seen && now.Sub(at) < d {
+					continue
+				}
+
+				lastEmit[key] = now
+				if !send(ctx, outChan, val) {
+					return
+				}
 			}
 		}
 	}()
@@ -181,21 +366,52 @@ func Batch[T any](ctx context.Context, in <-chan T, batchSize int, timeout time.
 // elapses, the timer is reset. This is useful for handling rapid bursts of events
 // where you only want to process the final value after activity stops.
 //
+// A continuous stream that never pauses for d would starve the output. Pass
+// WithMaxWait to force out the pending value once it has been held for that long
+// since the start of the burst; normal debouncing then resumes.
+//
 // Example:
 //
 //	Input:  [1, 2, 3] (arrive within 100ms of each other)
 //	Duration: 100ms
 //	Output: [3] (only after 100ms of silence after receiving 3)
+//
+//	Input:  a value every 10ms, forever
+//	Duration: 100ms, WithMaxWait(300ms)
+//	Output: the latest value roughly every 300ms
 func Debounce[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) <-chan T {
+	cfg := &chanConfig[T]{bufferSize: 0}
+	for _, opt := range opts {
+		opt(cfg)
+	}
 	outChan := applyChanOptions(opts...)
 
 	go func() {
 		defer close(outChan)
 
-		var timer *time.Timer
-		var timerCh <-chan time.Time
+		var timer, maxTimer *time.Timer
+		var timerCh, maxTimerCh <-chan time.Time
 		var pending *T
 
+		stopMaxTimer := func() {
+			if maxTimer != nil {
+				maxTimer.Stop()
+				maxTimer, maxTimerCh = nil, nil
+			}
+		}
+		defer stopMaxTimer()
+
+		emit := func() bool {
+			stopMaxTimer()
+			select {
+			case outChan <- *pending:
+				pending = nil
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -207,10 +423,7 @@ func Debounce[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...
 			case val, ok := <-in:
 				if !ok {
 					if pending != nil {
-						select {
-						case outChan <- *pending:
-						case <-ctx.Done():
-						}
+						emit()
 					}
 					if timer != nil {
 						timer.Stop()
@@ -218,6 +431,10 @@ func Debounce[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...
 					return
 				}
 
+				if pending == nil && cfg.maxWait > 0 {
+					maxTimer = time.NewTimer(cfg.maxWait)
+					maxTimerCh = maxTimer.C
+				}
 				pending = &val
 
 				if timer == nil {
@@ -229,14 +446,828 @@ func Debounce[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...
 				}
 
 			case <-timerCh:
-				if pending != nil {
-					select {
-					case outChan <- *pending:
-						pending = nil
-					case <-ctx.Done():
+				if pending != nil && !emit() {
+					return
+				}
+
+			case <-maxTimerCh:
+				if pending != nil && !emit() {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DebounceRobust emits the pending value after quiet has passed without new values, or once
+// maxWait has passed since the pending value was first set, whichever comes first. A pending
+// value is also flushed when the input closes. It is shorthand for Debounce with WithMaxWait.
+//
+// Example:
+//
+//	Input:  [1, 2] (burst), silence, then a value every 10ms, then close
+//	Quiet: 50ms, MaxWait: 120ms
+//	Output: [2] (50ms after 2), the latest value every ~120ms, the last value on close
+func DebounceRobust[T any](ctx context.Context, in <-chan T, quiet, maxWait time.Duration, opts ...ChanOption[T]) <-chan T {
+	opts = append(opts[:len(opts):len(opts)], WithMaxWait[T](maxWait))
+	return Debounce(ctx, in, quiet, opts...)
+}
+
+// Stabilize combines rate limiting with quiet-period settling. It caps the emission
+// rate at one value per throttle interval and guarantees that the final value of a
+// burst is emitted once the input has been quiet for the debounce duration.
+//
+// Emission timeline:
+//   - A value arriving when at least throttle has elapsed since the previous emission
+//     (or the very first value) is emitted immediately.
+//   - Any other value becomes the pending value, replacing an older pending one.
+//   - The pending value is emitted once debounce has elapsed since it arrived, but never
+//     sooner than throttle after the previous emission.
+//   - When the input closes, a pending value is flushed before the output closes.
+//
+// Example:
+//
+//	Input:  [1, 2, 3] (arrive within 10ms), then silence
+//	Throttle: 100ms, Debounce: 50ms
+//	Output: [1] (at 0ms), [3] (at 100ms) - value 2 was dropped
+func Stabilize[T any](ctx context.Context, in <-chan T, throttle, debounce time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		timer := time.NewTimer(debounce)
+		timer.Stop()
+		defer timer.Stop()
+
+		var pending *T
+		var lastEmit time.Time
+
+		emit := func(val T) bool {
+			if !send(ctx, outChan, val) {
+				return false
+			}
+			lastEmit = time.Now()
+			pending = nil
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					if pending != nil {
+						send(ctx, outChan, *pending)
+					}
+					return
+				}
+
+				now := time.Now()
+				if lastEmit.IsZero() || now.Sub(lastEmit) >= throttle {
+					timer.Stop()
+					if !emit(val) {
 						return
 					}
+					continue
+				}
+
+				pending = &val
+				deadline := now.Add(debounce)
+				if windowEnd := lastEmit.Add(throttle); windowEnd.After(deadline) {
+					deadline = windowEnd
+				}
+				timer.Reset(deadline.Sub(now))
+
+			case <-timer.C:
+				if pending != nil && !emit(*pending) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// ThrottleLatest limits the rate of values using leading-edge throttling while never
+// losing the most recent value. The first value of a window is emitted immediately and
+// opens a window of duration d. Values arriving during the window replace a single
+// pending value, which is emitted when the window ends (opening a new window). When the
+// input closes, the pending value is always emitted even if the window hasn't elapsed.
+//
+// Example:
+//
+//	Input:  [1, 2, 3, 4, 5] (all arrive at time 0), then input closes
+//	Duration: 100ms
+//	Output: [1] (at 0ms), [5] (on close) - values 2-4 were dropped
+func ThrottleLatest[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		timer := time.NewTimer(d)
+		timer.Stop()
+		defer timer.Stop()
+
+		var pending *T
+		inWindow := false
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					if pending != nil {
+						send(ctx, outChan, *pending)
+					}
+					return
+				}
+
+				if inWindow {
+					pending = &val
+					continue
+				}
+
+				if !send(ctx, outChan, val) {
+					return
+				}
+				inWindow = true
+				timer.Reset(d)
+
+			case <-timer.C:
+				if pending == nil {
+					inWindow = false
+					continue
+				}
+
+				if !send(ctx, outChan, *pending) {
+					return
+				}
+				pending = nil
+				timer.Reset(d)
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// BackpressureThrottle paces the input by refusing to receive the next value until minGap
+// has elapsed since the previous receive. Nothing is dropped and nothing is buffered:
+// a cooperating producer blocked on its send is naturally slowed to the given rate.
+// Unlike FixedInterval, which queues values it has already accepted, this operator
+// pushes the waiting back onto the producer.
+//
+// Example:
+//
+//	Input:  [1, 2, 3] (producer ready at time 0)
+//	MinGap: 100ms
+//	Output: [1] (at 0ms), [2] (at 100ms), [3] (at 200ms)
+func BackpressureThrottle[T any](ctx context.Context, in <-chan T, minGap time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		timer := time.NewTimer(minGap)
+		timer.Stop()
+		defer timer.Stop()
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+			timer.Reset(minGap)
+
+			if !send(ctx, outChan, val) {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DebounceReduce folds each burst of values with fn and emits the accumulated result
+// once the specified duration has elapsed without any new values arriving. The first
+// value of a burst seeds the accumulator; each later value is combined via fn(acc, v).
+// The accumulator resets after every emission. If the input closes mid-burst, the
+// accumulated result is emitted before the output closes.
+//
+// Example:
+//
+//	Input:  [3, 7, 2] (burst), silence, [1, 4] (burst)
+//	Duration: 100ms, fn: max
+//	Output: [7] (100ms after 2), [4] (100ms after 4)
+func DebounceReduce[T any](ctx context.Context, in <-chan T, d time.Duration, fn func(acc, v T) T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		var pending *T
+
+		for {
+			select {
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					if pending != nil {
+						select {
+						case outChan <- *pending:
+						case <-ctx.Done():
+						}
+					}
+					if timer != nil {
+						timer.Stop()
+					}
+					return
+				}
+
+				if pending == nil {
+					pending = &val
+				} else {
+					acc := fn(*pending, val)
+					pending = &acc
+				}
+
+				if timer == nil {
+					timer = time.NewTimer(d)
+					timerCh = timer.C
+				} else {
+					timer.Stop()
+					timer.Reset(d)
+				}
+
+			case <-timerCh:
+				if pending != nil {
+					select {
+					case outChan <- *pending:
+						pending = nil
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// SampleOn emits the most recent input value each time the clock channel ticks.
+// Intermediate values between ticks are dropped, and nothing is emitted on a tick if no
+// new value has arrived since the previous one. This is Throttle driven by a
+// user-supplied clock instead of a fixed duration.
+// The output channel closes when the input or the clock closes, or context is cancelled.
+//
+// Example:
+//
+//	Input:  [1, 2] (before tick 1), [3] (before tick 3)
+//	Clock:  tick 1, tick 2, tick 3
+//	Output: [2] (at tick 1), [3] (at tick 3)
+func SampleOn[T, S any](ctx context.Context, in <-chan T, clock <-chan S, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		var pending *T
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					return
+				}
+				pending = &val
+
+			case _, ok := <-clock:
+				if !ok {
+					go drain(in)
+					return
+				}
+				if pending != nil {
+					if !send(ctx, outChan, *pending) {
+						return
+					}
+					pending = nil
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Settle emits a value once it has been received stableCount consecutive times.
+// Further repeats of the same value are not emitted again; the operator waits for the
+// value to change and become stable anew. This filters out noise from jittery signals.
+//
+// Example:
+//
+//	Input:  [1, 1, 1, 2, 2, 1, 1, 1]
+//	StableCount: 3
+//	Output: [1, 1] - the run of 2s was too short to settle
+func Settle[T comparable](ctx context.Context, in <-chan T, stableCount int, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		var current T
+		run := 0
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if run == 0 || val != current {
+				current = val
+				run = 0
+			}
+			run++
+
+			if run == stableCount && !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// RateBounded emits the latest input value while bounding both the gap between emissions
+// and the time a value may be held. A pending value is emitted as soon as minGap has
+// elapsed since the previous emission (immediately if it already has), keeping only the
+// most recent value in the meantime. Independently, a value is never held longer than
+// maxHold: once the oldest unsent value has waited that long, the latest value is emitted
+// even if minGap has not yet elapsed. When the two bounds conflict, maxHold wins.
+// A pending value is flushed when the input closes.
+//
+// Example:
+//
+//	Input:  [1] (at 0ms), [2, 3] (at 10ms), [4] (at 500ms)
+//	MinGap: 100ms, MaxHold: 300ms
+//	Output: [1] (at 0ms), [3] (at 100ms), [4] (at 500ms)
+func RateBounded[T any](ctx context.Context, in <-chan T, minGap, maxHold time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		timer := time.NewTimer(minGap)
+		timer.Stop()
+		defer timer.Stop()
+
+		var pending *T
+		var pendingSince, lastEmit time.Time
+
+		emit := func() bool {
+			if !send(ctx, outChan, *pending) {
+				return false
+			}
+			lastEmit = time.Now()
+			pending = nil
+			return true
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					if pending != nil {
+						send(ctx, outChan, *pending)
+					}
+					return
+				}
+
+				now := time.Now()
+				if pending == nil {
+					pendingSince = now
+				}
+				pending = &val
+
+				deadline := pendingSince.Add(maxHold)
+				if !lastEmit.IsZero() {
+					if gapEnd := lastEmit.Add(minGap); gapEnd.Before(deadline) {
+						deadline = gapEnd
+					}
+				} else {
+					deadline = now
+				}
+
+				if !deadline.After(now) {
+					timer.Stop()
+					if !emit() {
+						return
+					}
+					continue
+				}
+				timer.Reset(deadline.Sub(now))
+
+			case <-timer.C:
+				if pending != nil && !emit() {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Coalesce is equivalent to DebounceReduce; it is an alias for call sites where the
+// intent reads as aggregating bursts rather than debouncing.
+func Coalesce[T any](ctx context.Context, in <-chan T, gap time.Duration, fn func(acc, v T) T, opts ...ChanOption[T]) <-chan T {
+	return DebounceReduce(ctx, in, gap, fn, opts...)
+}
+
+// CoalesceByKey keeps only the latest value per key and emits the collected map once every
+// window, then starts a new, empty map. This compacts state-update streams where only the
+// newest update for each entity matters. Windows with no values emit nothing, and a pending
+// map is emitted when the input closes. Each emitted map is owned by the receiver.
+//
+// Example:
+//
+//	Input:  [{a 1}, {b 1}, {a 2}] in the first window, [{b 2}] in the second
+//	Window: 100ms, keyFn: entity id
+//	Output: [map[a:{a 2} b:{b 1}]] (100ms), [map[b:{b 2}]] (200ms)
+func CoalesceByKey[T any, K comparable](ctx context.Context, in <-chan T, keyFn func(T) K, window time.Duration, opts ...ChanOption[map[K]T]) <-chan map[K]T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		ticker := time.NewTicker(window)
+		defer ticker.Stop()
+
+		latest := make(map[K]T)
+		flush := func() bool {
+			if len(latest) == 0 {
+				return true
+			}
+			pending := latest
+			latest = make(map[K]T)
+			return send(ctx, outChan, pending)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				latest[keyFn(val)] = val
+
+			case <-ticker.C:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// OverflowPolicy decides what Buffer does with a new value when its buffer is full.
+type OverflowPolicy int
+
+const (
+	// Block stops receiving from the input until the consumer frees a slot.
+	Block OverflowPolicy = iota
+	// DropNewest discards the incoming value and keeps the buffered ones.
+	DropNewest
+	// DropOldest evicts the oldest buffered value to make room for the incoming one.
+	DropOldest
+)
+
+// BufferStats reports on a Buffer stage. It is safe to read concurrently while the stage runs.
+type BufferStats struct {
+	highWater atomic.Int64
+	length    atomic.Int64
+}
+
+// HighWaterMark returns the maximum number of values held in the buffer at any point so far.
+// It never exceeds the buffer size, which makes it useful for sizing buffers.
+func (s *BufferStats) HighWaterMark() int {
+	return int(s.highWater.Load())
+}
+
+// Len returns the number of values currently held in the buffer.
+func (s *BufferStats) Len() int {
+	return int(s.length.Load())
+}
+
+// Buffer decouples a producer from a slow consumer by holding up to 'size' values in an
+// internal ring. When the ring is full, policy decides whether to block the producer,
+// drop the incoming value or evict the oldest one. The returned BufferStats records how
+// full the ring got. When the input closes, buffered values are flushed before the output closes.
+//
+// Example:
+//
+//	Input:  [1, 2, 3, 4, 5] (fast), consumer reads once everything has arrived
+//	Size: 2, Policy: DropOldest
+//	Output: [4, 5] (earlier values were evicted), HighWaterMark: 2
+func Buffer[T any](ctx context.Context, in <-chan T, size int, policy OverflowPolicy, opts ...ChanOption[T]) (<-chan T, *BufferStats) {
+	outChan := applyChanOptions(opts...)
+	stats := &BufferStats{}
+	size = max(size, 1)
+
+	go func() {
+		defer close(outChan)
+
+		ring := make([]T, size)
+		head, count := 0, 0
+		push := func(v T) {
+			ring[(head+count)%size] = v
+			count++
+			stats.length.Store(int64(count))
+			if int64(count) > stats.highWater.Load() {
+				stats.highWater.Store(int64(count))
+			}
+		}
+		pop := func() {
+			var zero T
+			ring[head] = zero
+			head = (head + 1) % size
+			count--
+			stats.length.Store(int64(count))
+		}
+
+		input := in
+		for {
+			// Nil channels disable the corresponding select cases.
+			recv := input
+			if count == size && policy == Block {
+				recv = nil
+			}
+			var out chan<- T
+			var next T
+			if count > 0 {
+				out = outChan
+				next = ring[head]
+			}
+
+			if input == nil && count == 0 {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-recv:
+				if !ok {
+					input = nil
+					continue
+				}
+				if count == size {
+					if policy == DropNewest {
+						continue
+					}
+					pop()
+				}
+				push(val)
+
+			case out <- next:
+				pop()
+			}
+		}
+	}()
+
+	return outChan, stats
+}
+
+// ChannelQueue is a FIFO queue with channel endpoints: values sent to In are held in a
+// Buffer stage of fixed capacity and received from Out in order. When the queue is full,
+// sends to In block until the consumer catches up. Len reports how many values are queued.
+type ChannelQueue[T any] struct {
+	in        chan T
+	out       <-chan T
+	stats     *BufferStats
+	closeOnce sync.Once
+}
+
+// NewChannelQueue starts a queue holding up to capacity values. When ctx is cancelled, Out
+// closes and queued values are discarded; producers should select on ctx.Done() when sending.
+//
+// Example:
+//
+//	q := NewChannelQueue[Job](ctx, 100)
+//	go func() { defer q.Close(); for _, j := range jobs { q.In() <- j } }()
+//	for j := range q.Out() { process(j) }
+func NewChannelQueue[T any](ctx context.Context, capacity int) *ChannelQueue[T] {
+	in := make(chan T)
+	out, stats := Buffer(ctx, in, capacity, Block)
+	return &ChannelQueue[T]{in: in, out: out, stats: stats}
+}
+
+// In returns the channel producers send values to.
+func (q *ChannelQueue[T]) In() chan<- T {
+	return q.in
+}
+
+// Out returns the channel consumers receive values from, in the order they were sent.
+func (q *ChannelQueue[T]) Out() <-chan T {
+	return q.out
+}
+
+// Len returns the number of values currently waiting in the queue.
+func (q *ChannelQueue[T]) Len() int {
+	return q.stats.Len()
+}
+
+// Close stops accepting values; queued values are still delivered before Out closes.
+// It is safe to call more than once, but sending to In after Close panics.
+func (q *ChannelQueue[T]) Close() {
+	q.closeOnce.Do(func() { close(q.in) })
+}
+
+// Pausable forwards values from the input channel until told to pause. Sending false on
+// control pauses forwarding and sending true resumes it; the stream starts unpaused.
+// By default a paused stage stops receiving, so values wait upstream and nothing is lost.
+// With WithDropWhilePaused, values keep being received while paused and are discarded.
+// If control closes, the current state is kept. The output channel closes when the
+// input closes or context is cancelled.
+//
+// Example:
+//
+//	Input:   [1, 2, 3, 4]
+//	Control: false after 1, true after a while
+//	Output:  [1], then nothing while paused, then [2, 3, 4] (or [4] with WithDropWhilePaused
+//	         if 2 and 3 arrived during the pause)
+func Pausable[T any](ctx context.Context, in <-chan T, control <-chan bool, opts ...ChanOption[T]) <-chan T {
+	cfg := &chanConfig[T]{bufferSize: 0}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		paused := false
+
+		for {
+			// A nil channel disables the receive case while paused.
+			recv := in
+			if paused && !cfg.dropPaused {
+				recv = nil
+			}
+
+			select {
+			case <-ctx.Done():
+				go drain(in)
+				return
+
+			case resume, ok := <-control:
+				if !ok {
+					control = nil
+					continue
+				}
+				paused = !resume
+
+			case val, ok := <-recv:
+				if !ok {
+					return
+				}
+				if paused {
+					continue
+				}
+				if !send(ctx, outChan, val) {
+					go drain(in)
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// AdaptiveThrottle limits output to roughly targetRate values per second, measured over a
+// sliding horizon of about one second. The emission window adapts to the recent output rate:
+// while fewer than targetRate values have gone out within the horizon, arriving values pass
+// immediately, so a burst after a quiet spell is not cut down to one value per 1/targetRate.
+// Once the budget is spent, the window stretches until the oldest emission leaves the horizon,
+// and only the latest value received meanwhile is emitted then. A pending value is emitted when
+// the input closes. A targetRate of zero or less passes everything.
+//
+// Example:
+//
+//	Input:      10 values within 10ms after a quiet second, then 500 values/sec
+//	TargetRate: 20
+//	Output:     all 10 values at once, then about 20 values/sec
+func AdaptiveThrottle[T any](ctx context.Context, in <-chan T, targetRate float64, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+	if targetRate <= 0 {
+		go func() {
+			defer close(outChan)
+			forwardSimple(ctx, outChan, in)
+		}()
+		return outChan
+	}
+
+	budget := max(1, int(math.Round(targetRate)))
+	horizon := time.Duration(float64(budget) / targetRate * float64(time.Second))
+
+	go func() {
+		defer close(outChan)
+
+		timer := time.NewTimer(horizon)
+		timer.Stop()
+		defer timer.Stop()
+		var timerCh <-chan time.Time
+
+		// recent holds the times of the last 'budget' emissions; head is the oldest once full.
+		recent := make([]time.Time, 0, budget)
+		head := 0
+		nextSlot := func() time.Time {
+			if len(recent) < budget {
+				return time.Time{}
+			}
+			return recent[head].Add(horizon)
+		}
+		emit := func(v T) bool {
+			if !send(ctx, outChan, v) {
+				return false
+			}
+			if len(recent) < budget {
+				recent = append(recent, time.Now())
+			} else {
+				recent[head] = time.Now()
+				head = (head + 1) % budget
+			}
+			return true
+		}
+
+		var pending *T
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					if pending != nil {
+						send(ctx, outChan, *pending)
+					}
+					return
+				}
+
+				if pending == nil && !time.Now().Before(nextSlot()) {
+					if !emit(val) {
+						return
+					}
+					continue
+				}
+
+				pending = &val
+				if timerCh == nil {
+					timer.Reset(time.Until(nextSlot()))
+					timerCh = timer.C
+				}
+
+			case <-timerCh:
+				timerCh = nil
+				if !emit(*pending) {
+					return
 				}
+				pending = nil
 			}
 		}
 	}()
@@ -2,6 +2,7 @@ package chankit
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -275,6 +276,27 @@ func TestThrottle(t *testing.T) {
 		close(in)
 	})
 
+	t.Run("emits pending value on input close", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+
+		out := Throttle(ctx, in, time.Second)
+
+		go func() {
+			in <- 5
+			close(in)
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		if len(results) != 1 || results[0] != 5 {
+			t.Errorf("expected [5], got %v", results)
+		}
+	})
+
 	t.Run("exact timing validation", func(t *testing.T) {
 		ctx := context.Background()
 		in := make(chan int)
@@ -310,6 +332,189 @@ func TestThrottle(t *testing.T) {
 	})
 }
 
+// TestThrottleWithStats tests the ThrottleWithStats function
+func TestThrottleWithStats(t *testing.T) {
+	t.Run("counts balance after a run", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out, stats := ThrottleWithStats(ctx, in, 30*time.Millisecond)
+
+		go func() {
+			defer close(in)
+			for i := 1; i <= 20; i++ {
+				in <- i
+				time.Sleep(5 * time.Millisecond)
+			}
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		received, emitted, dropped := stats.Received.Load(), stats.Emitted.Load(), stats.Dropped.Load()
+		if received != 20 {
+			t.Errorf("expected 20 received, got %d", received)
+		}
+		if emitted != int64(len(results)) {
+			t.Errorf("expected emitted %d to match output length %d", emitted, len(results))
+		}
+		if dropped == 0 {
+			t.Error("expected some values to be dropped")
+		}
+		if received != emitted+dropped {
+			t.Errorf("expected received (%d) == emitted (%d) + dropped (%d)", received, emitted, dropped)
+		}
+		if len(results) == 0 || results[len(results)-1] != 20 {
+			t.Errorf("expected last emitted value to be 20, got %v", results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out, stats := ThrottleWithStats(ctx, in, time.Hour)
+
+		in <- 1
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+
+		if stats.Received.Load() != 1 || stats.Dropped.Load() != 1 {
+			t.Errorf("expected 1 received and 1 dropped, got %d and %d", stats.Received.Load(), stats.Dropped.Load())
+		}
+	})
+}
+
+// TestThrottleByKey tests the ThrottleByKey function
+func TestThrottleFlushable(t *testing.T) {
+	t.Run("flush emits pending value before the tick", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		flush := make(chan struct{})
+		out := ThrottleFlushable(ctx, in, 200*time.Millisecond, flush)
+
+		start := time.Now()
+		in <- 1
+		in <- 2
+		flush <- struct{}{}
+
+		select {
+		case val := <-out:
+			if val != 2 {
+				t.Errorf("expected 2, got %d", val)
+			}
+			if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+				t.Errorf("expected flush before the tick, got value after %v", elapsed)
+			}
+		case <-time.After(150 * time.Millisecond):
+			t.Fatal("flush did not emit the pending value")
+		}
+
+		close(in)
+		if _, ok := <-out; ok {
+			t.Error("expected no further values after the flushed one")
+		}
+	})
+
+	t.Run("throttles normally without flush", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := ThrottleFlushable(ctx, in, 50*time.Millisecond, nil)
+
+		go func() {
+			for i := 1; i <= 3; i++ {
+				in <- i
+			}
+			time.Sleep(100 * time.Millisecond)
+			close(in)
+		}()
+
+		results := ChanToSlice(ctx, out)
+		if len(results) != 1 || results[0] != 3 {
+			t.Errorf("expected [3], got %v", results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := ThrottleFlushable(ctx, make(chan int), 50*time.Millisecond, make(chan struct{}))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestThrottleByKey(t *testing.T) {
+	type event struct {
+		key string
+		seq int
+	}
+	byKey := func(e event) string { return e.key }
+
+	t.Run("throttles each key independently", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan event)
+		out := ThrottleByKey(ctx, in, byKey, 80*time.Millisecond)
+
+		go func() {
+			defer close(in)
+			for _, e := range []event{{"a", 1}, {"b", 1}, {"a", 2}, {"b", 2}, {"a", 3}} {
+				in <- e
+			}
+			time.Sleep(120 * time.Millisecond)
+			in <- event{"a", 4}
+			in <- event{"a", 5}
+		}()
+
+		var results []event
+		for e := range out {
+			results = append(results, e)
+		}
+
+		expected := []event{{"a", 1}, {"b", 1}, {"a", 4}}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, e := range results {
+			if e != expected[i] {
+				t.Errorf("at index %d: expected %v, got %v", i, expected[i], e)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := ThrottleByKey(ctx, make(chan event), byKey, time.Second)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
 // TestFixedInterval tests the FixedInterval function
 func TestFixedInterval(t *testing.T) {
 	t.Run("processes all values at fixed rate", func(t *testing.T) {
@@ -419,6 +624,7 @@ func TestFixedInterval(t *testing.T) {
 
 	t.Run("context cancellation", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 		in := make(chan int, 100)
 		intervalDuration := 50 * time.Millisecond
 
@@ -1440,4 +1646,1153 @@ func TestDebounce(t *testing.T) {
 			t.Errorf("expected value 5, got %d", results[0])
 		}
 	})
+
+	t.Run("max wait forces emissions on a steady stream", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := Debounce(ctx, in, 50*time.Millisecond, WithMaxWait[int](100*time.Millisecond))
+
+		go func() {
+			defer close(in)
+			deadline := time.Now().Add(450 * time.Millisecond)
+			for i := 1; time.Now().Before(deadline); i++ {
+				in <- i
+				time.Sleep(10 * time.Millisecond)
+			}
+		}()
+
+		var times []time.Time
+		var results []int
+		for val := range out {
+			times = append(times, time.Now())
+			results = append(results, val)
+		}
+
+		// Without max wait the stream never pauses, so only the final flush would appear.
+		// With it, roughly one forced emission per 100ms plus the final flush.
+		if len(results) < 4 || len(results) > 6 {
+			t.Fatalf("expected 4-6 emissions, got %d: %v", len(results), results)
+		}
+		for i := 1; i < len(times)-1; i++ {
+			gap := times[i].Sub(times[i-1])
+			if gap < 70*time.Millisecond || gap > 160*time.Millisecond {
+				t.Errorf("emission %d: expected gap around 100ms, got %v", i, gap)
+			}
+		}
+		for i := 1; i < len(results); i++ {
+			if results[i] <= results[i-1] {
+				t.Errorf("expected increasing values, got %v", results)
+				break
+			}
+		}
+	})
+}
+
+// TestDebounceRobust tests the DebounceRobust function
+func TestDebounceRobust(t *testing.T) {
+	t.Run("silence, max wait and close each trigger emissions", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := DebounceRobust(ctx, in, 50*time.Millisecond, 120*time.Millisecond)
+
+		go func() {
+			// Phase 1: a short burst followed by silence.
+			in <- 1
+			in <- 2
+			time.Sleep(100 * time.Millisecond)
+
+			// Phase 2: a steady stream that never goes quiet for 50ms.
+			for i := 10; i < 40; i++ {
+				in <- i
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			// Phase 3: one last value, then close before quiet elapses.
+			in <- 99
+			close(in)
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		if len(results) < 4 {
+			t.Fatalf("expected at least 4 emissions, got %v", results)
+		}
+		if results[0] != 2 {
+			t.Errorf("expected silence-triggered emission of 2, got %d", results[0])
+		}
+		forced := 0
+		for _, v := range results[1 : len(results)-1] {
+			if v >= 10 && v < 40 {
+				forced++
+			}
+		}
+		if forced < 2 {
+			t.Errorf("expected at least 2 max-wait emissions during the steady stream, got %v", results)
+		}
+		if last := results[len(results)-1]; last != 99 {
+			t.Errorf("expected close-triggered emission of 99, got %d", last)
+		}
+	})
+}
+
+// TestStabilize tests the Stabilize function
+func TestStabilize(t *testing.T) {
+	t.Run("caps emission rate during a burst", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		throttleDuration := 100 * time.Millisecond
+
+		out := Stabilize(ctx, in, throttleDuration, 50*time.Millisecond)
+
+		go func() {
+			for i := 1; i <= 30; i++ {
+				in <- i
+				time.Sleep(10 * time.Millisecond)
+			}
+			close(in)
+		}()
+
+		var results []int
+		var timestamps []time.Duration
+		start := time.Now()
+
+		for val := range out {
+			results = append(results, val)
+			timestamps = append(timestamps, time.Since(start))
+		}
+
+		if len(results) == 0 || len(results) >= 30 {
+			t.Fatalf("expected throttled output, got %d values", len(results))
+		}
+
+		for i := 1; i < len(timestamps); i++ {
+			interval := timestamps[i] - timestamps[i-1]
+			if interval < throttleDuration-20*time.Millisecond {
+				t.Errorf("interval %d: expected >= ~%v, got %v", i, throttleDuration, interval)
+			}
+		}
+
+		if results[len(results)-1] != 30 {
+			t.Errorf("expected final value 30 to be emitted, got %d", results[len(results)-1])
+		}
+	})
+
+	t.Run("emits trailing value after quiet period", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		debounceDuration := 50 * time.Millisecond
+
+		out := Stabilize(ctx, in, 20*time.Millisecond, debounceDuration)
+
+		start := time.Now()
+		go func() {
+			in <- 1
+			in <- 2
+			in <- 3
+			time.Sleep(150 * time.Millisecond)
+			close(in)
+		}()
+
+		first := <-out
+		if first != 1 {
+			t.Errorf("expected leading value 1, got %d", first)
+		}
+
+		trailing := <-out
+		elapsed := time.Since(start)
+		if trailing != 3 {
+			t.Errorf("expected trailing value 3, got %d", trailing)
+		}
+		if elapsed < debounceDuration-10*time.Millisecond {
+			t.Errorf("trailing value arrived too early: %v", elapsed)
+		}
+		if elapsed > 140*time.Millisecond {
+			t.Errorf("trailing value should be emitted before input closes, got %v", elapsed)
+		}
+
+		if _, ok := <-out; ok {
+			t.Error("expected channel to be closed")
+		}
+	})
+
+	t.Run("flushes pending value on close", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+
+		var results []int
+		for val := range Stabilize(ctx, in, time.Second, time.Second) {
+			results = append(results, val)
+		}
+
+		if len(results) != 2 || results[0] != 1 || results[1] != 3 {
+			t.Errorf("expected [1 3], got %v", results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Stabilize(ctx, in, 50*time.Millisecond, 50*time.Millisecond)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestThrottleLatest tests the ThrottleLatest function
+func TestThrottleLatest(t *testing.T) {
+	t.Run("emits leading value immediately", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := ThrottleLatest(ctx, in, 200*time.Millisecond)
+
+		start := time.Now()
+		go func() {
+			in <- 1
+		}()
+
+		select {
+		case val := <-out:
+			if val != 1 {
+				t.Errorf("expected 1, got %d", val)
+			}
+			if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+				t.Errorf("leading value should be immediate, took %v", elapsed)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("timeout waiting for leading value")
+		}
+		close(in)
+	})
+
+	t.Run("burst then close does not lose last value", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int, 5)
+		for i := 1; i <= 5; i++ {
+			in <- i
+		}
+		close(in)
+
+		var results []int
+		for val := range ThrottleLatest(ctx, in, time.Second) {
+			results = append(results, val)
+		}
+
+		if len(results) != 2 || results[0] != 1 || results[1] != 5 {
+			t.Errorf("expected [1 5], got %v", results)
+		}
+	})
+
+	t.Run("emits trailing value at window end", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		throttleDuration := 50 * time.Millisecond
+		out := ThrottleLatest(ctx, in, throttleDuration)
+
+		go func() {
+			in <- 1
+			in <- 2
+			in <- 3
+			time.Sleep(200 * time.Millisecond)
+			close(in)
+		}()
+
+		var results []int
+		var timestamps []time.Duration
+		start := time.Now()
+		for val := range out {
+			results = append(results, val)
+			timestamps = append(timestamps, time.Since(start))
+		}
+
+		if len(results) != 2 || results[0] != 1 || results[1] != 3 {
+			t.Fatalf("expected [1 3], got %v", results)
+		}
+		if timestamps[1] < throttleDuration-10*time.Millisecond {
+			t.Errorf("trailing value arrived before window end: %v", timestamps[1])
+		}
+		if timestamps[1] > 150*time.Millisecond {
+			t.Errorf("trailing value should be emitted at window end, got %v", timestamps[1])
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := ThrottleLatest(ctx, in, 50*time.Millisecond)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestBackpressureThrottle tests the BackpressureThrottle function
+func TestBackpressureThrottle(t *testing.T) {
+	t.Run("paces the producer without dropping", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		minGap := 50 * time.Millisecond
+
+		out := BackpressureThrottle(ctx, in, minGap)
+
+		sendTimes := make(chan time.Duration, 5)
+		start := time.Now()
+		go func() {
+			defer close(in)
+			defer close(sendTimes)
+			for i := 1; i <= 5; i++ {
+				in <- i
+				sendTimes <- time.Since(start)
+			}
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		expected := []int{1, 2, 3, 4, 5}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d values, got %d", len(expected), len(results))
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+
+		var timestamps []time.Duration
+		for ts := range sendTimes {
+			timestamps = append(timestamps, ts)
+		}
+		for i := 1; i < len(timestamps); i++ {
+			gap := timestamps[i] - timestamps[i-1]
+			if gap < minGap-20*time.Millisecond {
+				t.Errorf("send %d: producer was not paced, gap %v", i, gap)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int, 1)
+		in <- 1
+
+		out := BackpressureThrottle(ctx, in, time.Second)
+		<-out
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestDebounceReduce tests the DebounceReduce function
+func TestDebounceReduce(t *testing.T) {
+	maxFn := func(acc, v int) int {
+		if v > acc {
+			return v
+		}
+		return acc
+	}
+
+	t.Run("emits the max of each burst", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := DebounceReduce(ctx, in, 50*time.Millisecond, maxFn)
+
+		go func() {
+			for _, v := range []int{3, 7, 2} {
+				in <- v
+				time.Sleep(5 * time.Millisecond)
+			}
+			time.Sleep(100 * time.Millisecond)
+			for _, v := range []int{1, 4} {
+				in <- v
+				time.Sleep(5 * time.Millisecond)
+			}
+			time.Sleep(100 * time.Millisecond)
+			close(in)
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		expected := []int{7, 4}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d values, got %d: %v", len(expected), len(results), results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("flushes accumulated value on close", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+		close(in)
+
+		var results []int
+		for val := range DebounceReduce(ctx, in, time.Second, func(acc, v int) int { return acc + v }) {
+			results = append(results, val)
+		}
+
+		if len(results) != 1 || results[0] != 6 {
+			t.Errorf("expected [6], got %v", results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := DebounceReduce(ctx, in, 50*time.Millisecond, maxFn)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSampleOn tests the SampleOn function
+func TestSampleOn(t *testing.T) {
+	t.Run("emits latest value on each clock tick", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		clock := make(chan struct{})
+
+		out := SampleOn(ctx, in, clock)
+
+		expectNext := func(expected int) {
+			t.Helper()
+			select {
+			case val := <-out:
+				if val != expected {
+					t.Errorf("expected %d, got %d", expected, val)
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatalf("timeout waiting for %d", expected)
+			}
+		}
+
+		in <- 1
+		in <- 2
+		clock <- struct{}{}
+		expectNext(2)
+
+		// No new value since the last tick: nothing is emitted
+		clock <- struct{}{}
+		select {
+		case val := <-out:
+			t.Fatalf("unexpected value %d on idle tick", val)
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		in <- 3
+		clock <- struct{}{}
+		expectNext(3)
+
+		close(in)
+		if _, ok := <-out; ok {
+			t.Error("expected channel to be closed")
+		}
+	})
+
+	t.Run("closes when clock closes", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		clock := make(chan int)
+
+		out := SampleOn(ctx, in, clock)
+		close(clock)
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after clock closed")
+		}
+		close(in)
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		clock := make(chan struct{})
+		out := SampleOn(ctx, in, clock)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSettle tests the Settle function
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       []int
+		stableCount int
+		expected    []int
+	}{
+		{"emits stable runs", []int{1, 1, 1, 2, 2, 1, 1, 1}, 3, []int{1, 1}},
+		{"long run emits once", []int{5, 5, 5, 5, 5, 5}, 2, []int{5}},
+		{"never stable", []int{1, 2, 1, 2}, 2, nil},
+		{"stable count of one passes changes", []int{1, 1, 2, 3, 3}, 1, []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			in := SliceToChan(ctx, tt.input)
+
+			var results []int
+			for val := range Settle(ctx, in, tt.stableCount) {
+				results = append(results, val)
+			}
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, results)
+			}
+			for i, v := range results {
+				if v != tt.expected[i] {
+					t.Errorf("at index %d: expected %d, got %d", i, tt.expected[i], v)
+				}
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Settle(ctx, in, 3)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestRateBounded tests the RateBounded function
+func TestRateBounded(t *testing.T) {
+	t.Run("respects minimum gap under bursty input", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		minGap := 50 * time.Millisecond
+
+		out := RateBounded(ctx, in, minGap, 500*time.Millisecond)
+
+		go func() {
+			for i := 1; i <= 20; i++ {
+				in <- i
+				time.Sleep(5 * time.Millisecond)
+			}
+			close(in)
+		}()
+
+		var results []int
+		var timestamps []time.Duration
+		start := time.Now()
+		for val := range out {
+			results = append(results, val)
+			timestamps = append(timestamps, time.Since(start))
+		}
+
+		if len(results) == 0 || len(results) >= 20 {
+			t.Fatalf("expected rate-limited output, got %d values", len(results))
+		}
+		for i := 1; i < len(timestamps)-1; i++ {
+			if gap := timestamps[i] - timestamps[i-1]; gap < minGap-15*time.Millisecond {
+				t.Errorf("interval %d: expected >= ~%v, got %v", i, minGap, gap)
+			}
+		}
+		if results[len(results)-1] != 20 {
+			t.Errorf("expected latest value 20 to be emitted last, got %d", results[len(results)-1])
+		}
+	})
+
+	t.Run("sparse input is emitted without delay", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := RateBounded(ctx, in, 30*time.Millisecond, 200*time.Millisecond)
+
+		go func() {
+			for i := 1; i <= 3; i++ {
+				in <- i
+				time.Sleep(60 * time.Millisecond)
+			}
+			close(in)
+		}()
+
+		count := 0
+		for range out {
+			count++
+		}
+		if count != 3 {
+			t.Errorf("expected all 3 sparse values, got %d", count)
+		}
+	})
+
+	t.Run("max hold forces emission before min gap", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		maxHold := 50 * time.Millisecond
+		out := RateBounded(ctx, in, time.Second, maxHold)
+
+		go func() {
+			in <- 1
+			in <- 2
+			time.Sleep(200 * time.Millisecond)
+			close(in)
+		}()
+
+		start := time.Now()
+		if val := <-out; val != 1 {
+			t.Errorf("expected leading value 1, got %d", val)
+		}
+
+		val := <-out
+		elapsed := time.Since(start)
+		if val != 2 {
+			t.Errorf("expected 2, got %d", val)
+		}
+		if elapsed < maxHold-10*time.Millisecond || elapsed > maxHold+60*time.Millisecond {
+			t.Errorf("expected forced emission after ~%v, got %v", maxHold, elapsed)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := RateBounded(ctx, in, 50*time.Millisecond, 100*time.Millisecond)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestCoalesce tests the Coalesce function
+func TestCoalesce(t *testing.T) {
+	ctx := context.Background()
+	out := Coalesce(ctx, SliceToChan(ctx, []int{1, 2, 3, 4}), time.Second, func(acc, v int) int { return acc + v })
+
+	var results []int
+	for val := range out {
+		results = append(results, val)
+	}
+
+	if len(results) != 1 || results[0] != 10 {
+		t.Errorf("expected [10], got %v", results)
+	}
+}
+
+// TestCoalesceByKey tests the CoalesceByKey function
+func TestCoalesceByKey(t *testing.T) {
+	type update struct {
+		key string
+		val int
+	}
+	byKey := func(u update) string { return u.key }
+
+	t.Run("keeps the latest update per key in each window", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan update)
+		out := CoalesceByKey(ctx, in, byKey, 100*time.Millisecond)
+
+		go func() {
+			in <- update{"a", 1}
+			in <- update{"b", 1}
+			in <- update{"a", 2}
+			time.Sleep(150 * time.Millisecond)
+			in <- update{"b", 2}
+			in <- update{"b", 3}
+			time.Sleep(100 * time.Millisecond)
+			close(in)
+		}()
+
+		var results []map[string]update
+		for m := range out {
+			results = append(results, m)
+		}
+
+		expected := []map[string]update{
+			{"a": {"a", 2}, "b": {"b", 1}},
+			{"b": {"b", 3}},
+		}
+		if !reflect.DeepEqual(results, expected) {
+			t.Errorf("expected %v, got %v", expected, results)
+		}
+	})
+
+	t.Run("flushes pending map on close", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []update{{"a", 1}, {"a", 2}})
+		out := CoalesceByKey(ctx, in, byKey, time.Second)
+
+		var results []map[string]update
+		for m := range out {
+			results = append(results, m)
+		}
+
+		expected := []map[string]update{{"a": {"a", 2}}}
+		if !reflect.DeepEqual(results, expected) {
+			t.Errorf("expected %v, got %v", expected, results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := CoalesceByKey(ctx, make(chan update), byKey, time.Second)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestBuffer tests the Buffer function
+func TestBuffer(t *testing.T) {
+	t.Run("block policy preserves all values in order", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8})
+		out, _ := Buffer(ctx, in, 3, Block)
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		expected := []int{1, 2, 3, 4, 5, 6, 7, 8}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("high-water mark with fast producer and slow consumer", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+		out, stats := Buffer(ctx, in, 4, Block)
+
+		count := 0
+		for range out {
+			count++
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		if count != 10 {
+			t.Errorf("expected 10 values, got %d", count)
+		}
+		hwm := stats.HighWaterMark()
+		if hwm == 0 || hwm > 4 {
+			t.Errorf("expected high-water mark in (0, 4], got %d", hwm)
+		}
+	})
+
+	t.Run("drop policies", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			policy   OverflowPolicy
+			expected []int
+		}{
+			{"drop oldest keeps newest", DropOldest, []int{4, 5}},
+			{"drop newest keeps oldest", DropNewest, []int{1, 2}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				in := make(chan int)
+				out, stats := Buffer(ctx, in, 2, tt.policy)
+
+				for i := 1; i <= 5; i++ {
+					in <- i
+				}
+				close(in)
+
+				var results []int
+				for val := range out {
+					results = append(results, val)
+				}
+
+				if len(results) != len(tt.expected) {
+					t.Fatalf("expected %v, got %v", tt.expected, results)
+				}
+				for i, v := range results {
+					if v != tt.expected[i] {
+						t.Errorf("at index %d: expected %d, got %d", i, tt.expected[i], v)
+					}
+				}
+				if stats.HighWaterMark() != 2 {
+					t.Errorf("expected high-water mark 2, got %d", stats.HighWaterMark())
+				}
+			})
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out, _ := Buffer(ctx, in, 2, Block)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestPausable tests the Pausable function
+// TestChannelQueue tests the ChannelQueue type
+func TestChannelQueue(t *testing.T) {
+	t.Run("blocks when full and drains in order", func(t *testing.T) {
+		ctx := context.Background()
+		q := NewChannelQueue[int](ctx, 3)
+
+		sent := make(chan int, 1)
+		go func() {
+			defer q.Close()
+			count := 0
+			for i := 1; i <= 6; i++ {
+				q.In() <- i
+				count++
+			}
+			sent <- count
+		}()
+
+		deadline := time.After(time.Second)
+		for q.Len() < 3 {
+			select {
+			case <-deadline:
+				t.Fatalf("queue never filled, Len() = %d", q.Len())
+			case <-time.After(time.Millisecond):
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		select {
+		case <-sent:
+			t.Fatal("producer was not blocked by a full queue")
+		default:
+		}
+		if n := q.Len(); n != 3 {
+			t.Errorf("expected Len() = 3 while full, got %d", n)
+		}
+
+		var result []int
+		for val := range q.Out() {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3, 4, 5, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if n := q.Len(); n != 0 {
+			t.Errorf("expected Len() = 0 after draining, got %d", n)
+		}
+	})
+
+	t.Run("close is idempotent", func(t *testing.T) {
+		ctx := context.Background()
+		q := NewChannelQueue[int](ctx, 2)
+
+		q.Close()
+		q.Close()
+
+		if _, ok := <-q.Out(); ok {
+			t.Error("expected Out to be closed")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		q := NewChannelQueue[int](ctx, 2)
+		q.In() <- 1
+
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			for range q.Out() {
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("Out did not close after cancellation")
+		}
+	})
+}
+
+func TestPausable(t *testing.T) {
+	expectValue := func(t *testing.T, out <-chan int, expected int) {
+		t.Helper()
+		select {
+		case v := <-out:
+			if v != expected {
+				t.Errorf("expected %d, got %d", expected, v)
+			}
+		case <-time.After(200 * time.Millisecond):
+			t.Fatalf("timed out waiting for %d", expected)
+		}
+	}
+	expectNothing := func(t *testing.T, out <-chan int) {
+		t.Helper()
+		select {
+		case v := <-out:
+			t.Errorf("expected no value while paused, got %d", v)
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	t.Run("withholds values while paused and resumes", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		control := make(chan bool)
+		out := Pausable(ctx, in, control)
+
+		in <- 1
+		expectValue(t, out, 1)
+
+		control <- false
+		go func() {
+			defer close(in)
+			in <- 2
+			in <- 3
+		}()
+		expectNothing(t, out)
+
+		control <- true
+		expectValue(t, out, 2)
+		expectValue(t, out, 3)
+
+		if _, ok := <-out; ok {
+			t.Error("expected channel to be closed")
+		}
+	})
+
+	t.Run("drops values while paused with option", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		control := make(chan bool)
+		out := Pausable(ctx, in, control, WithDropWhilePaused[int]())
+
+		control <- false
+		in <- 1
+		in <- 2
+		expectNothing(t, out)
+
+		control <- true
+		go func() {
+			defer close(in)
+			in <- 3
+		}()
+		expectValue(t, out, 3)
+
+		if _, ok := <-out; ok {
+			t.Error("expected channel to be closed")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Pausable(ctx, make(chan int), make(chan bool))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestAdaptiveThrottle tests the AdaptiveThrottle function
+func TestAdaptiveThrottle(t *testing.T) {
+	t.Run("never exceeds the target within any one-second horizon", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 1300*time.Millisecond)
+		defer cancel()
+		in := make(chan int)
+		out := AdaptiveThrottle(ctx, in, 20)
+
+		go func() {
+			for i := 0; ; i++ {
+				select {
+				case in <- i:
+				case <-ctx.Done():
+					return
+				}
+				time.Sleep(2 * time.Millisecond)
+			}
+		}()
+
+		var times []time.Time
+		for range out {
+			times = append(times, time.Now())
+		}
+
+		if len(times) < 30 || len(times) > 45 {
+			t.Errorf("expected about 20 values per second over 1.3s, got %d", len(times))
+		}
+		for i := 20; i < len(times); i++ {
+			if gap := times[i].Sub(times[i-20]); gap < 950*time.Millisecond {
+				t.Fatalf("values %d and %d are only %v apart, exceeding 20 per second", i-20, i, gap)
+			}
+		}
+	})
+
+	t.Run("lets a burst through after a quiet spell", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := AdaptiveThrottle(ctx, in, 20)
+
+		go func() {
+			defer close(in)
+			for i := 1; i <= 10; i++ {
+				in <- i
+				time.Sleep(time.Millisecond)
+			}
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		// A fixed 50ms throttle would keep only the first and the last of these values.
+		expected := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		if !reflect.DeepEqual(results, expected) {
+			t.Errorf("expected %v, got %v", expected, results)
+		}
+	})
+
+	t.Run("passes slow steady input through", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := AdaptiveThrottle(ctx, in, 20)
+
+		go func() {
+			defer close(in)
+			for i := 1; i <= 5; i++ {
+				in <- i
+				time.Sleep(100 * time.Millisecond)
+			}
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		expected := []int{1, 2, 3, 4, 5}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := AdaptiveThrottle(ctx, make(chan int), 10)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
 }
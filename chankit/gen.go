@@ -1,6 +1,17 @@
 package chankit
 
-import "context"
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// Numeric is the set of integer and floating-point types accepted by the arithmetic
+// helpers such as Range and Delta.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
 
 // Generate creates a channel that produces values from a generator function.
 // The generator function returns (value, true) to produce a value, or (zero, false) to stop.
@@ -33,6 +44,93 @@ func Generate[T any](ctx context.Context, genFunc func() (T, bool), opts ...Chan
 	return outChan
 }
 
+// GenerateErr creates a channel that produces values from a fallible generator function.
+// Generation stops at the first non-nil error and the channel is closed. Returning io.EOF
+// signals a clean stop; any other error is exposed through the returned function, which
+// should be called once the channel has been drained.
+//
+// Examples:
+//
+//	ch, errFn := GenerateErr(ctx, func() (Row, error) { return rows.Next() })
+//	for row := range ch { ... }
+//	if err := errFn(); err != nil { ... }
+func GenerateErr[T any](ctx context.Context, genFunc func() (T, error), opts ...ChanOption[T]) (<-chan T, func() error) {
+	outChan := applyChanOptions(opts...)
+	var errs errHolder
+
+	go func() {
+		defer close(outChan)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				val, err := genFunc()
+				if err != nil {
+					if !errors.Is(err, io.EOF) {
+						errs.set(err)
+					}
+					return
+				}
+
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan, errs.get
+}
+
+// FromResource streams values from a resource that must be released afterwards, such as a
+// file or a connection. open acquires the resource, next produces values until it reports
+// false, and closeFn releases it. closeFn is called exactly once if open succeeded, whether
+// the stream is exhausted, next fails, or the context is cancelled, and it has returned by the
+// time the output channel closes. The first error from open, next or closeFn is exposed through
+// the returned function, which should be called once the channel has been drained.
+//
+// Examples:
+//
+//	lines, errFn := FromResource(ctx,
+//	    func() (*bufio.Scanner, error) { ... },                     // open the file
+//	    func(s *bufio.Scanner) (string, bool, error) { ... },       // next line
+//	    func(s *bufio.Scanner) error { ... })                       // close the file
+//	for line := range lines { ... }
+//	if err := errFn(); err != nil { ... }
+func FromResource[T, R any](ctx context.Context, open func() (R, error), next func(R) (T, bool, error), closeFn func(R) error, opts ...ChanOption[T]) (<-chan T, func() error) {
+	outChan := applyChanOptions(opts...)
+	var errs errHolder
+
+	go func() {
+		defer close(outChan)
+
+		res, err := open()
+		if err != nil {
+			errs.set(err)
+			return
+		}
+		defer func() {
+			if err := closeFn(res); err != nil {
+				errs.set(err)
+			}
+		}()
+
+		for ctx.Err() == nil {
+			val, more, err := next(res)
+			if err != nil {
+				errs.set(err)
+				return
+			}
+			if !more || !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan, errs.get
+}
+
 // Repeat creates a channel that infinitely repeats the given value.
 // The channel will close when the context is cancelled.
 //
@@ -65,7 +163,7 @@ func Repeat[T any](ctx context.Context, value T, opts ...ChanOption[T]) <-chan T
 //	Range(ctx, 10, 0, -1)          // 10, 9, 8, ..., 1
 //	Range(ctx, 0, 5, 2)            // 0, 2, 4
 //	Range(ctx, 0, 10, 1, WithBuffer[int](5))  // buffered
-func Range[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64](ctx context.Context, start, end, step T, opts ...ChanOption[T]) <-chan T {
+func Range[T Numeric](ctx context.Context, start, end, step T, opts ...ChanOption[T]) <-chan T {
 	ch := applyChanOptions(opts...)
 
 	go func() {
@@ -91,3 +189,259 @@ func Range[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32
 
 	return ch
 }
+
+// TimeRange creates a channel that produces timestamps from start to end (exclusive), stepping by step.
+// It is a value generator, not a scheduler: timestamps are emitted as fast as they are consumed.
+// For positive steps: generates [start, start+step, ...) while t is before end
+// For negative steps: generates [start, start+step, ...) while t is after end
+//
+// Examples:
+//
+//	TimeRange(ctx, midnight, midnight.Add(24*time.Hour), time.Hour)    // 24 hourly timestamps
+//	TimeRange(ctx, now, now.Add(-time.Minute), -10*time.Second)        // 6 timestamps going back
+func TimeRange(ctx context.Context, start, end time.Time, step time.Duration, opts ...ChanOption[time.Time]) <-chan time.Time {
+	ch := applyChanOptions(opts...)
+
+	go func() {
+		defer close(ch)
+
+		if step > 0 {
+			for t := start; t.Before(end); t = t.Add(step) {
+				if !send(ctx, ch, t) {
+					return
+				}
+			}
+			return
+		}
+
+		if step < 0 {
+			for t := start; t.After(end); t = t.Add(step) {
+				if !send(ctx, ch, t) {
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Expand creates a channel that expands each seed into a sequence of values.
+// For every seed received, gen builds a generator function which is drained until it
+// returns (zero, false) before the next seed is read, so the output is the in-order
+// concatenation of all expansions.
+//
+// Examples:
+//
+//	// seeds [2, 3] -> 0, 1, 0, 1, 2
+//	Expand(ctx, seeds, func(n int) func() (int, bool) {
+//		i := 0
+//		return func() (int, bool) {
+//			i++
+//			return i - 1, i <= n
+//		}
+//	})
+func Expand[S, T any](ctx context.Context, seeds <-chan S, gen func(S) func() (T, bool), opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for {
+			seed, ok := recieve(ctx, seeds)
+			if !ok {
+				return
+			}
+
+			next := gen(seed)
+			for {
+				val, more := next()
+				if !more {
+					break
+				}
+
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Defer creates a channel whose source is built by factory on the operator's goroutine
+// rather than by the caller, then forwards every value from it.
+// Constructing a Defer is therefore cheap and never blocks, even if factory is slow.
+// Note that Go channels cannot observe a pending receive, so factory runs as soon as the
+// goroutine is scheduled, not on the first read. If the context is cancelled before that,
+// factory is never invoked.
+//
+// Examples:
+//
+//	Defer(ctx, func() <-chan Row { return queryRows(ctx) })          // build the source in the background
+//	Defer(ctx, expensiveSource, WithBuffer[Row](100))                // buffered
+func Defer[T any](ctx context.Context, factory func() <-chan T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		if ctx.Err() != nil {
+			return
+		}
+
+		forwardSimple(ctx, outChan, factory())
+	}()
+
+	return outChan
+}
+
+// ConcatGen creates a channel that drains each generator in turn. A generator is not called
+// until the previous one has returned (zero, false), so later generators start lazily.
+// The channel closes after the last generator is exhausted or when the context is cancelled.
+//
+// Examples:
+//
+//	ConcatGen(ctx, counter(1, 3), counter(10, 11))   // 1, 2, 3, 10, 11
+//	ConcatGen(ctx, readHeader, readBody)               // header values, then body values
+func ConcatGen[T any](ctx context.Context, gens ...func() (T, bool)) <-chan T {
+	outChan := make(chan T)
+
+	go func() {
+		defer close(outChan)
+		for _, gen := range gens {
+			for {
+				if ctx.Err() != nil {
+					return
+				}
+
+				val, ok := gen()
+				if !ok {
+					break
+				}
+
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// StartWith creates a channel that emits the given values first and then forwards
+// everything from the input channel. Context cancellation interrupts the initial
+// emission as well as the forwarding.
+//
+// Examples:
+//
+//	StartWith(ctx, ch, 1, 2)              // [3, 4] -> [1, 2, 3, 4]
+//	StartWith(ctx, updates, lastKnown)    // seed a live stream with its current state
+func StartWith[T any](ctx context.Context, in <-chan T, values ...T) <-chan T {
+	outChan := make(chan T)
+
+	go func() {
+		defer close(outChan)
+		for _, v := range values {
+			if !send(ctx, outChan, v) {
+				go drain(in)
+				return
+			}
+		}
+
+		forwardSimple(ctx, outChan, in)
+	}()
+
+	return outChan
+}
+
+// EndWith creates a channel that forwards everything from the input channel and then
+// emits the given values before closing. This is useful for appending sentinels or footers.
+// If the context is cancelled, the trailing values are not emitted.
+//
+// Examples:
+//
+//	EndWith(ctx, ch, 98, 99)              // [1, 2] -> [1, 2, 98, 99]
+//	EndWith(ctx, lines, "-- end --")      // append a footer
+func EndWith[T any](ctx context.Context, in <-chan T, values ...T) <-chan T {
+	outChan := make(chan T)
+
+	go func() {
+		defer close(outChan)
+		forwardSimple(ctx, outChan, in)
+
+		for _, v := range values {
+			if ctx.Err() != nil || !send(ctx, outChan, v) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Retry creates a channel fed by the stream that factory builds, rebuilding the stream when it fails.
+// Each Result value is forwarded as it arrives; the first Result carrying an error abandons the
+// current stream, waits for backoff and calls factory again, for at most attempts calls in total.
+// Values emitted before a failure are not retracted, so delivery is at-least-once.
+// Every error, including the final one, is passed to the WithErrorSink callback if one is set.
+// The channel closes when a stream completes cleanly, attempts are exhausted, or the context is cancelled.
+//
+// Examples:
+//
+//	Retry(ctx, func() <-chan Result[Row] { return query(ctx) }, 3, time.Second)
+//	Retry(ctx, fetchPages, 5, 100*time.Millisecond, WithErrorSink[Page](logErr))
+func Retry[T any](ctx context.Context, factory func() <-chan Result[T], attempts int, backoff time.Duration, opts ...ChanOption[T]) <-chan T {
+	cfg := &chanConfig[T]{bufferSize: 0}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		for attempt := 1; attempt <= max(attempts, 1); attempt++ {
+			if attempt > 1 {
+				timer := time.NewTimer(backoff)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+
+			failed, ok := forwardResults(ctx, outChan, factory(), cfg.errorSink)
+			if !ok || !failed {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// forwardResults forwards the values of results until it closes or yields an error.
+// It reports whether an error ended the stream, and false for ok if the context was cancelled.
+func forwardResults[T any](ctx context.Context, out chan<- T, results <-chan Result[T], sink func(error)) (failed bool, ok bool) {
+	for {
+		res, more := recieve(ctx, results)
+		if !more {
+			return false, ctx.Err() == nil
+		}
+
+		if res.Err != nil {
+			if sink != nil {
+				sink(res.Err)
+			}
+			go drain(results)
+			return true, true
+		}
+
+		if !send(ctx, out, res.Value) {
+			go drain(results)
+			return false, false
+		}
+	}
+}
@@ -2,6 +2,9 @@ package chankit
 
 import (
 	"context"
+	"errors"
+	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -159,7 +162,230 @@ func TestGenerate(t *testing.T) {
 	})
 }
 
+// TestGenerateErr tests the GenerateErr function
+func TestGenerateErr(t *testing.T) {
+	t.Run("clean stop on io.EOF", func(t *testing.T) {
+		ctx := context.Background()
+		counter := 0
+		ch, errFn := GenerateErr(ctx, func() (int, error) {
+			if counter < 3 {
+				counter++
+				return counter, nil
+			}
+			return 0, io.EOF
+		})
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %d values, got %d", len(expected), len(result))
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+		if err := errFn(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("mid-stream error", func(t *testing.T) {
+		ctx := context.Background()
+		errBoom := errors.New("boom")
+		counter := 0
+		ch, errFn := GenerateErr(ctx, func() (int, error) {
+			counter++
+			if counter == 3 {
+				return 0, errBoom
+			}
+			return counter, nil
+		})
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 2 {
+			t.Errorf("expected 2 values before error, got %d", len(result))
+		}
+		if err := errFn(); !errors.Is(err, errBoom) {
+			t.Errorf("expected %v, got %v", errBoom, err)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, errFn := GenerateErr(ctx, func() (int, error) { return 1, nil })
+
+		<-ch
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					if err := errFn(); err != nil {
+						t.Errorf("expected no error, got %v", err)
+					}
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
 // TestRepeat tests the Repeat function
+func TestFromResource(t *testing.T) {
+	type fakeFile struct {
+		lines  []string
+		pos    int
+		closed atomic.Int64
+	}
+	newFile := func(lines ...string) *fakeFile { return &fakeFile{lines: lines} }
+	next := func(f *fakeFile) (string, bool, error) {
+		if f.pos >= len(f.lines) {
+			return "", false, nil
+		}
+		f.pos++
+		return f.lines[f.pos-1], true, nil
+	}
+	closeFile := func(f *fakeFile) error {
+		f.closed.Add(1)
+		return nil
+	}
+
+	t.Run("streams values and closes when exhausted", func(t *testing.T) {
+		ctx := context.Background()
+		file := newFile("a", "b", "c")
+
+		ch, errFn := FromResource(ctx, func() (*fakeFile, error) { return file, nil }, next, closeFile)
+
+		var result []string
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 3 || result[0] != "a" || result[2] != "c" {
+			t.Errorf("expected [a b c], got %v", result)
+		}
+		if n := file.closed.Load(); n != 1 {
+			t.Errorf("expected close to be called once, got %d", n)
+		}
+		if err := errFn(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("next error closes the resource and is reported", func(t *testing.T) {
+		ctx := context.Background()
+		file := newFile("a", "b")
+		errRead := errors.New("read failed")
+
+		ch, errFn := FromResource(ctx,
+			func() (*fakeFile, error) { return file, nil },
+			func(f *fakeFile) (string, bool, error) {
+				if f.pos == 1 {
+					return "", false, errRead
+				}
+				return next(f)
+			},
+			closeFile)
+
+		var result []string
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 1 {
+			t.Errorf("expected 1 value before the error, got %v", result)
+		}
+		if n := file.closed.Load(); n != 1 {
+			t.Errorf("expected close to be called once, got %d", n)
+		}
+		if err := errFn(); !errors.Is(err, errRead) {
+			t.Errorf("expected %v, got %v", errRead, err)
+		}
+	})
+
+	t.Run("close error is reported", func(t *testing.T) {
+		ctx := context.Background()
+		errClose := errors.New("close failed")
+
+		ch, errFn := FromResource(ctx,
+			func() (*fakeFile, error) { return newFile("a"), nil },
+			next,
+			func(*fakeFile) error { return errClose })
+
+		for range ch {
+		}
+
+		if err := errFn(); !errors.Is(err, errClose) {
+			t.Errorf("expected %v, got %v", errClose, err)
+		}
+	})
+
+	t.Run("open error skips close", func(t *testing.T) {
+		ctx := context.Background()
+		errOpen := errors.New("open failed")
+		closed := false
+
+		ch, errFn := FromResource(ctx,
+			func() (*fakeFile, error) { return nil, errOpen },
+			next,
+			func(*fakeFile) error { closed = true; return nil })
+
+		if _, ok := <-ch; ok {
+			t.Error("expected closed channel")
+		}
+		if closed {
+			t.Error("expected close not to be called when open fails")
+		}
+		if err := errFn(); !errors.Is(err, errOpen) {
+			t.Errorf("expected %v, got %v", errOpen, err)
+		}
+	})
+
+	t.Run("context cancellation closes the resource", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		file := newFile()
+		endless := func(*fakeFile) (string, bool, error) { return "x", true, nil }
+
+		ch, errFn := FromResource(ctx, func() (*fakeFile, error) { return file, nil }, endless, closeFile)
+
+		<-ch
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			for range ch {
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+
+		if n := file.closed.Load(); n != 1 {
+			t.Errorf("expected close to be called once, got %d", n)
+		}
+		if err := errFn(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
 func TestRepeat(t *testing.T) {
 	t.Run("basic repeat", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
@@ -473,3 +699,465 @@ func TestRange(t *testing.T) {
 		}
 	})
 }
+
+// TestExpand tests the Expand function
+func TestTimeRange(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("hourly timestamps across a day", func(t *testing.T) {
+		ctx := context.Background()
+
+		var result []time.Time
+		for ts := range TimeRange(ctx, day, day.Add(24*time.Hour), time.Hour) {
+			result = append(result, ts)
+		}
+
+		if len(result) != 24 {
+			t.Fatalf("expected 24 timestamps, got %d", len(result))
+		}
+		for i, ts := range result {
+			if expected := day.Add(time.Duration(i) * time.Hour); !ts.Equal(expected) {
+				t.Errorf("at index %d: expected %v, got %v", i, expected, ts)
+			}
+		}
+	})
+
+	t.Run("negative step", func(t *testing.T) {
+		ctx := context.Background()
+
+		var result []time.Time
+		for ts := range TimeRange(ctx, day, day.Add(-time.Minute), -20*time.Second) {
+			result = append(result, ts)
+		}
+
+		if len(result) != 3 {
+			t.Fatalf("expected 3 timestamps, got %d", len(result))
+		}
+		if !result[2].Equal(day.Add(-40 * time.Second)) {
+			t.Errorf("expected last timestamp %v, got %v", day.Add(-40*time.Second), result[2])
+		}
+	})
+
+	t.Run("zero step produces nothing", func(t *testing.T) {
+		ctx := context.Background()
+
+		count := 0
+		for range TimeRange(ctx, day, day.Add(time.Hour), 0) {
+			count++
+		}
+
+		if count != 0 {
+			t.Errorf("expected 0 timestamps, got %d", count)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := TimeRange(ctx, day, day.Add(1000*time.Hour), time.Hour)
+
+		<-ch
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+func TestRetry(t *testing.T) {
+	boom := errors.New("boom")
+
+	t.Run("gives up after attempts", func(t *testing.T) {
+		ctx := context.Background()
+		calls := 0
+		var lastErr error
+
+		ch := Retry(ctx, func() <-chan Result[int] {
+			calls++
+			return SliceToChan(ctx, []Result[int]{{Value: calls}, {Err: boom}})
+		}, 2, time.Millisecond, WithErrorSink[int](func(err error) { lastErr = err }))
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 2 || result[0] != 1 || result[1] != 2 {
+			t.Errorf("expected [1 2], got %v", result)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 factory calls, got %d", calls)
+		}
+		if !errors.Is(lastErr, boom) {
+			t.Errorf("expected final error %v, got %v", boom, lastErr)
+		}
+	})
+
+	t.Run("clean completion does not retry", func(t *testing.T) {
+		ctx := context.Background()
+		calls := 0
+
+		ch := Retry(ctx, func() <-chan Result[int] {
+			calls++
+			return SliceToChan(ctx, []Result[int]{{Value: 7}})
+		}, 5, time.Millisecond)
+
+		for range ch {
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 factory call, got %d", calls)
+		}
+	})
+
+	t.Run("context cancellation during backoff", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := Retry(ctx, func() <-chan Result[int] {
+			return SliceToChan(ctx, []Result[int]{{Err: boom}})
+		}, 3, time.Hour)
+
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestExpand(t *testing.T) {
+	upTo := func(n int) func() (int, bool) {
+		i := 0
+		return func() (int, bool) {
+			if i >= n {
+				return 0, false
+			}
+			i++
+			return i - 1, true
+		}
+	}
+
+	t.Run("expands seeds in order", func(t *testing.T) {
+		ctx := context.Background()
+		seeds := SliceToChan(ctx, []int{2, 3})
+
+		var result []int
+		for val := range Expand(ctx, seeds, upTo) {
+			result = append(result, val)
+		}
+
+		expected := []int{0, 1, 0, 1, 2}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %d values, got %d", len(expected), len(result))
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("empty expansions produce nothing", func(t *testing.T) {
+		ctx := context.Background()
+		seeds := SliceToChan(ctx, []int{0, 0})
+
+		count := 0
+		for range Expand(ctx, seeds, upTo) {
+			count++
+		}
+
+		if count != 0 {
+			t.Errorf("expected 0 values, got %d", count)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		seeds := SliceToChan(ctx, []int{1000000})
+
+		ch := Expand(ctx, seeds, upTo)
+		<-ch
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+// TestDefer tests the Defer function
+func TestDefer(t *testing.T) {
+	t.Run("forwards values from the built source", func(t *testing.T) {
+		ctx := context.Background()
+		calls := 0
+
+		ch := Defer(ctx, func() <-chan int {
+			calls++
+			return SliceToChan(ctx, []int{1, 2, 3})
+		})
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %d values, got %d", len(expected), len(result))
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+		if calls != 1 {
+			t.Errorf("expected factory to be called once, got %d", calls)
+		}
+	})
+
+	t.Run("does not build the source on the caller's goroutine", func(t *testing.T) {
+		ctx := context.Background()
+		release := make(chan struct{})
+
+		start := time.Now()
+		ch := Defer(ctx, func() <-chan int {
+			<-release
+			return SliceToChan(ctx, []int{42})
+		})
+
+		if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+			t.Errorf("Defer blocked on factory for %v", elapsed)
+		}
+
+		close(release)
+		if val := <-ch; val != 42 {
+			t.Errorf("expected 42, got %d", val)
+		}
+	})
+
+	t.Run("cancelled context skips the factory", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := make(chan struct{}, 1)
+		ch := Defer(ctx, func() <-chan int {
+			called <- struct{}{}
+			return make(chan int)
+		})
+
+		if _, ok := <-ch; ok {
+			t.Error("expected channel to be closed")
+		}
+		select {
+		case <-called:
+			t.Error("factory should not be invoked after cancellation")
+		default:
+		}
+	})
+}
+
+func TestConcatGen(t *testing.T) {
+	counter := func(from, to int, calls *atomic.Int64) func() (int, bool) {
+		i := from
+		return func() (int, bool) {
+			calls.Add(1)
+			if i > to {
+				return 0, false
+			}
+			i++
+			return i - 1, true
+		}
+	}
+
+	t.Run("sequences generators in order", func(t *testing.T) {
+		ctx := context.Background()
+		var firstCalls, secondCalls atomic.Int64
+
+		ch := ConcatGen(ctx, counter(1, 3, &firstCalls), counter(10, 11, &secondCalls))
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3, 10, 11}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("second generator starts only after the first is exhausted", func(t *testing.T) {
+		ctx := context.Background()
+		var firstCalls, secondCalls atomic.Int64
+
+		ch := ConcatGen(ctx, counter(1, 3, &firstCalls), counter(10, 11, &secondCalls))
+
+		// After two reads the goroutine is blocked sending 3, still inside the first generator.
+		<-ch
+		<-ch
+		if calls := secondCalls.Load(); calls != 0 {
+			t.Errorf("expected second generator untouched, got %d calls", calls)
+		}
+
+		for range ch {
+		}
+		if firstCalls.Load() != 4 || secondCalls.Load() != 3 {
+			t.Errorf("expected 4 and 3 calls, got %d and %d", firstCalls.Load(), secondCalls.Load())
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		infinite := func() (int, bool) { return 1, true }
+
+		ch := ConcatGen(ctx, infinite)
+		<-ch
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+func TestStartWith(t *testing.T) {
+	t.Run("emits values before the source", func(t *testing.T) {
+		ctx := context.Background()
+		ch := StartWith(ctx, SliceToChan(ctx, []int{3, 4}), 1, 2)
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3, 4}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("no values passes the source through", func(t *testing.T) {
+		ctx := context.Background()
+		ch := StartWith(ctx, SliceToChan(ctx, []int{3, 4}))
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 2 || result[0] != 3 || result[1] != 4 {
+			t.Errorf("expected [3 4], got %v", result)
+		}
+	})
+
+	t.Run("context cancellation interrupts initial values", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := StartWith(ctx, make(chan int), 1, 2, 3)
+
+		if val := <-ch; val != 1 {
+			t.Fatalf("expected 1, got %d", val)
+		}
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+func TestEndWith(t *testing.T) {
+	t.Run("emits values after the source", func(t *testing.T) {
+		ctx := context.Background()
+		ch := EndWith(ctx, SliceToChan(ctx, []int{1, 2}), 98, 99)
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 98, 99}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("empty source emits only trailing values", func(t *testing.T) {
+		ctx := context.Background()
+		ch := EndWith(ctx, SliceToChan(ctx, []int{}), 99)
+
+		var result []int
+		for val := range ch {
+			result = append(result, val)
+		}
+
+		if len(result) != 1 || result[0] != 99 {
+			t.Errorf("expected [99], got %v", result)
+		}
+	})
+
+	t.Run("context cancellation skips trailing values", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := EndWith(ctx, make(chan int), 98, 99)
+
+		cancel()
+
+		select {
+		case _, ok := <-ch:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
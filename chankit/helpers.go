@@ -1,13 +1,21 @@
 package chankit
 
-import "context"
+import (
+	"context"
+	"sync"
+	"time"
+)
 
 // ChanOption is a functional option for configuring channel behavior
 type ChanOption[T any] func(*chanConfig[T])
 
 // chanConfig holds configuration for channel creation
 type chanConfig[T any] struct {
-	bufferSize int
+	bufferSize  int
+	concurrency int
+	errorSink   func(error)
+	maxWait     time.Duration
+	dropPaused  bool
 }
 
 // applyChanOptions creates a configured channel based on provided options
@@ -34,6 +42,40 @@ func WithBufferAuto[T any]() ChanOption[T] {
 	}
 }
 
+// WithConcurrency bounds how many goroutines an operator may run at once.
+// It only affects operators that document support for it, such as Flatten.
+// A value of zero or less means unbounded.
+func WithConcurrency[T any](n int) ChanOption[T] {
+	return func(cfg *chanConfig[T]) {
+		cfg.concurrency = n
+	}
+}
+
+// WithErrorSink sets a callback that observes errors an operator would otherwise discard.
+// It only affects operators that document support for it, such as SkipErrors.
+func WithErrorSink[T any](sink func(error)) ChanOption[T] {
+	return func(cfg *chanConfig[T]) {
+		cfg.errorSink = sink
+	}
+}
+
+// WithMaxWait caps how long an operator may hold a pending value before it is forced out.
+// It only affects operators that document support for it, such as Debounce.
+// A value of zero or less disables the cap.
+func WithMaxWait[T any](max time.Duration) ChanOption[T] {
+	return func(cfg *chanConfig[T]) {
+		cfg.maxWait = max
+	}
+}
+
+// WithDropWhilePaused makes a pausable operator discard values that arrive while it is paused
+// instead of holding them back. It only affects operators that document support for it, such as Pausable.
+func WithDropWhilePaused[T any]() ChanOption[T] {
+	return func(cfg *chanConfig[T]) {
+		cfg.dropPaused = true
+	}
+}
+
 // drain consumes all remaining values from a channel without processing them.
 // This is used to prevent goroutine leaks when context is cancelled but the
 // input channel still has pending values. By draining in a separate goroutine,
@@ -146,3 +188,26 @@ func send[T any](ctx context.Context, out chan<- T, val T) bool {
 		return true
 	}
 }
+
+// errHolder stores the first error reported by an operator's goroutine so it can be
+// read safely from the consumer side through the returned error closure.
+type errHolder struct {
+	mu  sync.Mutex
+	err error
+}
+
+// set records err if no error has been recorded yet.
+func (h *errHolder) set(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.err == nil {
+		h.err = err
+	}
+}
+
+// get returns the recorded error, or nil if none was recorded.
+func (h *errHolder) get() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.err
+}
@@ -1,7 +1,11 @@
 package chankit
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"io"
+	"slices"
 	"time"
 )
 
@@ -18,8 +22,9 @@ import (
 //	    Take(10).
 //	    ToSlice()
 type Pipeline[T any] struct {
-	ctx context.Context
-	ch  <-chan T
+	ctx    context.Context
+	ch     <-chan T
+	stages []string
 }
 
 // NewPipeline creates a new empty Pipeline with the given context.
@@ -45,8 +50,9 @@ func NewPipeline[T any](ctx context.Context) *Pipeline[T] {
 //	pipeline := chankit.From(ctx, ch).Map(func(x int) int { return x * 2 })
 func From[T any](ctx context.Context, ch <-chan T) *Pipeline[T] {
 	return &Pipeline[T]{
-		ctx: ctx,
-		ch:  ch,
+		ctx:    ctx,
+		ch:     ch,
+		stages: []string{"from"},
 	}
 }
 
@@ -58,7 +64,31 @@ func From[T any](ctx context.Context, ch <-chan T) *Pipeline[T] {
 //	    Map(func(x int) int { return x * 2 })
 func FromSlice[T any](ctx context.Context, slice []T) *Pipeline[T] {
 	ch := SliceToChan(ctx, slice)
-	return From(ctx, ch)
+	return From(ctx, ch).Named("fromSlice")
+}
+
+// Of creates a Pipeline from the given values.
+// It is a variadic shorthand for FromSlice.
+//
+// Example:
+//
+//	pipeline := chankit.Of(ctx, 1, 2, 3).
+//	    MapSame(func(x int) int { return x * 2 })
+func Of[T any](ctx context.Context, values ...T) *Pipeline[T] {
+	ch := SliceToChan(ctx, values)
+	return From(ctx, ch).Named("of")
+}
+
+// FromChannels creates a Pipeline that merges the given channels.
+// Values are interleaved in arrival order; the pipeline completes when all channels close.
+//
+// Example:
+//
+//	pipeline := chankit.FromChannels(ctx, clicks, keypresses).
+//	    Map(func(e Event) any { return e.Name })
+func FromChannels[T any](ctx context.Context, chans ...<-chan T) *Pipeline[T] {
+	ch := Merge(ctx, chans...)
+	return From(ctx, ch).Named("fromChannels")
 }
 
 // ============================================================================
@@ -71,9 +101,9 @@ func FromSlice[T any](ctx context.Context, slice []T) *Pipeline[T] {
 // Example:
 //
 //	pipeline := chankit.NewPipeline[int](ctx).RangePipeline(1, 10, 1)  // 1, 2, 3, ..., 9
-func RangePipeline[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | float32 | float64](ctx context.Context, start, end, step T) *Pipeline[T] {
+func RangePipeline[T Numeric](ctx context.Context, start, end, step T) *Pipeline[T] {
 	ch := Range(ctx, start, end, step)
-	return From(ctx, ch)
+	return From(ctx, ch).Named("range")
 }
 
 // Repeat generates an infinite stream of the same value.
@@ -86,7 +116,7 @@ func RangePipeline[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16
 //	    Take(5)  // ["ping", "ping", "ping", "ping", "ping"]
 func (p *Pipeline[T]) Repeat(value T) *Pipeline[T] {
 	ch := Repeat(p.ctx, value)
-	return From(p.ctx, ch)
+	return then(p, "repeat", ch)
 }
 
 // Generate creates values using a generator function.
@@ -101,7 +131,21 @@ func (p *Pipeline[T]) Repeat(value T) *Pipeline[T] {
 //	})
 func (p *Pipeline[T]) Generate(genFunc func() (T, bool), opts ...ChanOption[T]) *Pipeline[T] {
 	ch := Generate(p.ctx, genFunc, opts...)
-	return From(p.ctx, ch)
+	return then(p, "generate", ch)
+}
+
+// RetryPipeline builds a pipeline from factory and rebuilds it when it yields an error Result,
+// up to attempts builds in total with backoff between them. Values before a failure are kept,
+// so delivery is at-least-once. Use WithErrorSink to observe the errors.
+//
+// Example:
+//
+//	rows := chankit.RetryPipeline(ctx, func() *chankit.Pipeline[chankit.Result[Row]] {
+//	    return chankit.From(ctx, queryRows(ctx))
+//	}, 3, time.Second)
+func RetryPipeline[T any](ctx context.Context, factory func() *Pipeline[Result[T]], attempts int, backoff time.Duration, opts ...ChanOption[T]) *Pipeline[T] {
+	ch := Retry(ctx, func() <-chan Result[T] { return factory().Chan() }, attempts, backoff, opts...)
+	return From(ctx, ch).Named("retry")
 }
 
 // ============================================================================
@@ -117,7 +161,18 @@ func (p *Pipeline[T]) Generate(genFunc func() (T, bool), opts ...ChanOption[T])
 //	pipeline.Map(func(x int) string { return fmt.Sprintf("num_%d", x) })
 func (p *Pipeline[T]) Map(fn func(T) any) *Pipeline[any] {
 	ch := Map(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "map", ch)
+}
+
+// MapSame transforms each value while preserving the element type.
+// Unlike Map, it avoids boxing values into any, so no type assertions are needed downstream.
+//
+// Example:
+//
+//	pipeline.MapSame(func(x int) int { return x * 2 })
+func (p *Pipeline[T]) MapSame(fn func(T) T) *Pipeline[T] {
+	ch := Map(p.ctx, p.ch, fn)
+	return then(p, "mapSame", ch)
 }
 
 // MapTo is a type-safe version of Map that explicitly specifies the output type.
@@ -127,7 +182,7 @@ func (p *Pipeline[T]) Map(fn func(T) any) *Pipeline[any] {
 //	pipeline.MapTo(func(x int) string { return fmt.Sprint(x) })
 func MapTo[T, R any](p *Pipeline[T], fn func(T) R) *Pipeline[R] {
 	ch := Map(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "mapTo", ch)
 }
 
 // Filter keeps only values that satisfy the predicate.
@@ -138,7 +193,7 @@ func MapTo[T, R any](p *Pipeline[T], fn func(T) R) *Pipeline[R] {
 //	pipeline.Filter(func(x int) bool { return x > 10 })    // numbers > 10
 func (p *Pipeline[T]) Filter(fn func(T) bool) *Pipeline[T] {
 	ch := Filter(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "filter", ch)
 }
 
 // FlatMap transforms each value into a channel and flattens the results.
@@ -156,7 +211,124 @@ func (p *Pipeline[T]) Filter(fn func(T) bool) *Pipeline[T] {
 //	})
 func (p *Pipeline[T]) FlatMap(fn func(T) <-chan T) *Pipeline[T] {
 	ch := FlatMap(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "flatMap", ch)
+}
+
+// Enumerate pairs each value with its zero-based position.
+// Returns a channel since the element type changes.
+//
+// Example:
+//
+//	for item := range pipeline.Enumerate() {
+//	    fmt.Println(item.Index, item.Value)
+//	}
+func (p *Pipeline[T]) Enumerate() <-chan struct {
+	Index int
+	Value T
+} {
+	return Enumerate(p.ctx, p.ch)
+}
+
+// DistinctByPipeline removes duplicates by a derived key while staying in the fluent chain.
+// Only the first value for each key is kept, so element types need not be comparable.
+//
+// Example:
+//
+//	unique := DistinctByPipeline(users, func(u User) int { return u.ID })
+func DistinctByPipeline[T any, K comparable](p *Pipeline[T], keyFn func(T) K) *Pipeline[T] {
+	ch := DistinctBy(p.ctx, p.ch, keyFn)
+	return then(p, "distinctBy", ch)
+}
+
+// DistinctWindowPipeline suppresses values already emitted within the last ttl.
+// It is a free function because the element type must be comparable.
+//
+// Example:
+//
+//	alerts := DistinctWindowPipeline(alertPipeline, time.Minute)  // each alert at most once per minute
+func DistinctWindowPipeline[T comparable](p *Pipeline[T], ttl time.Duration) *Pipeline[T] {
+	ch := DistinctWindow(p.ctx, p.ch, ttl)
+	return then(p, "distinctWindow", ch)
+}
+
+// DistinctLastNPipeline suppresses values that appeared among the last n emitted values.
+// It is a free function because the element type must be comparable.
+//
+// Example:
+//
+//	recent := DistinctLastNPipeline(ids, 100)  // dedupe within the last 100 emissions
+func DistinctLastNPipeline[T comparable](p *Pipeline[T], n int) *Pipeline[T] {
+	ch := DistinctLastN(p.ctx, p.ch, n)
+	return then(p, "distinctLastN", ch)
+}
+
+// MergeMapTo maps each value to a channel and merges the results, draining at most
+// concurrency inner channels at a time. Output order is not guaranteed.
+//
+// Example:
+//
+//	pages := MergeMapTo(urls, 4, func(url string) <-chan Page { return fetch(ctx, url) })
+func MergeMapTo[T, R any](p *Pipeline[T], concurrency int, fn func(T) <-chan R) *Pipeline[R] {
+	ch := MergeMap(p.ctx, p.ch, concurrency, fn)
+	return then(p, "mergeMapTo", ch)
+}
+
+// ConcatMapTo maps each value to a channel and forwards the channels one after another,
+// preserving input order.
+//
+// Example:
+//
+//	lines := ConcatMapTo(paths, func(path string) <-chan string { return readLines(ctx, path) })
+func ConcatMapTo[T, R any](p *Pipeline[T], fn func(T) <-chan R) *Pipeline[R] {
+	ch := ConcatMap(p.ctx, p.ch, fn)
+	return then(p, "concatMapTo", ch)
+}
+
+// SwitchMapTo maps each value to a channel, forwarding only from the most recent one.
+// Earlier inner channels are abandoned as soon as a new value arrives.
+//
+// Example:
+//
+//	results := SwitchMapTo(queries, func(q string) <-chan Result { return search(ctx, q) })
+func SwitchMapTo[T, R any](p *Pipeline[T], fn func(T) <-chan R) *Pipeline[R] {
+	ch := SwitchMap(p.ctx, p.ch, fn)
+	return then(p, "switchMapTo", ch)
+}
+
+// GroupByKey splits the pipeline into a dynamic set of keyed sub-pipelines.
+// Returns a channel of groups since each group carries its own pipeline.
+// Sub-pipelines must be consumed concurrently, or buffered via WithBuffer, since a value
+// waiting on one group blocks dispatch to all others.
+//
+// Example:
+//
+//	for g := range GroupByKey(pipeline, func(x int) int { return x % 3 }) {
+//	    go func() { fmt.Println(g.Key, g.Pipeline.Count()) }()
+//	}
+func GroupByKey[T any, K comparable](p *Pipeline[T], keyFn func(T) K, opts ...ChanOption[T]) <-chan struct {
+	Key      K
+	Pipeline *Pipeline[T]
+} {
+	out := make(chan struct {
+		Key      K
+		Pipeline *Pipeline[T]
+	})
+
+	go func() {
+		defer close(out)
+		for g := range GroupBy(p.ctx, p.ch, keyFn, opts...) {
+			group := struct {
+				Key      K
+				Pipeline *Pipeline[T]
+			}{g.Key, then(p, "groupByKey", g.Values)}
+			if !send(p.ctx, out, group) {
+				go drain(g.Values)
+				return
+			}
+		}
+	}()
+
+	return out
 }
 
 // ============================================================================
@@ -170,7 +342,7 @@ func (p *Pipeline[T]) FlatMap(fn func(T) <-chan T) *Pipeline[T] {
 //	pipeline.Take(5)  // first 5 values only
 func (p *Pipeline[T]) Take(n int) *Pipeline[T] {
 	ch := Take(p.ctx, p.ch, n)
-	return From(p.ctx, ch)
+	return then(p, "take", ch)
 }
 
 // Skip discards the first n values and emits the rest.
@@ -180,7 +352,7 @@ func (p *Pipeline[T]) Take(n int) *Pipeline[T] {
 //	pipeline.Skip(5)  // skip first 5 values
 func (p *Pipeline[T]) Skip(n int) *Pipeline[T] {
 	ch := Skip(p.ctx, p.ch, n)
-	return From(p.ctx, ch)
+	return then(p, "skip", ch)
 }
 
 // TakeWhile emits values as long as the predicate is true.
@@ -191,7 +363,7 @@ func (p *Pipeline[T]) Skip(n int) *Pipeline[T] {
 //	pipeline.TakeWhile(func(x int) bool { return x < 10 })
 func (p *Pipeline[T]) TakeWhile(fn func(T) bool) *Pipeline[T] {
 	ch := TakeWhile(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "takeWhile", ch)
 }
 
 // SkipWhile discards values as long as the predicate is true.
@@ -202,7 +374,39 @@ func (p *Pipeline[T]) TakeWhile(fn func(T) bool) *Pipeline[T] {
 //	pipeline.SkipWhile(func(x int) bool { return x < 10 })
 func (p *Pipeline[T]) SkipWhile(fn func(T) bool) *Pipeline[T] {
 	ch := SkipWhile(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "skipWhile", ch)
+}
+
+// TakeLast emits only the last n values, once the upstream closes.
+//
+// Example:
+//
+//	pipeline.TakeLast(3)  // last 3 values only
+func (p *Pipeline[T]) TakeLast(n int) *Pipeline[T] {
+	ch := TakeLast(p.ctx, p.ch, n)
+	return then(p, "takeLast", ch)
+}
+
+// Slice skips the first offset values and then emits up to limit values.
+//
+// Example:
+//
+//	pipeline.Slice(20, 10)  // values 20-29, like OFFSET 20 LIMIT 10
+func (p *Pipeline[T]) Slice(offset, limit int) *Pipeline[T] {
+	ch := Slice(p.ctx, p.ch, offset, limit)
+	return then(p, "slice", ch)
+}
+
+// Page emits the values of the given 1-based page, where each page holds size values.
+// It is Slice with the offset computed from the page number; pages below 1 are treated as 1.
+//
+// Example:
+//
+//	pipeline.Page(3, 25)  // values 50-74
+func (p *Pipeline[T]) Page(number, size int) *Pipeline[T] {
+	offset := max(number-1, 0) * size
+	ch := Slice(p.ctx, p.ch, offset, size)
+	return then(p, "page", ch)
 }
 
 // ============================================================================
@@ -217,7 +421,7 @@ func (p *Pipeline[T]) SkipWhile(fn func(T) bool) *Pipeline[T] {
 //	pipeline.Throttle(100 * time.Millisecond)  // at most 1 value per 100ms
 func (p *Pipeline[T]) Throttle(d time.Duration) *Pipeline[T] {
 	ch := Throttle(p.ctx, p.ch, d)
-	return From(p.ctx, ch)
+	return then(p, "throttle", ch)
 }
 
 // Debounce emits values only after a period of silence.
@@ -228,7 +432,7 @@ func (p *Pipeline[T]) Throttle(d time.Duration) *Pipeline[T] {
 //	pipeline.Debounce(300 * time.Millisecond)  // wait 300ms of silence
 func (p *Pipeline[T]) Debounce(d time.Duration) *Pipeline[T] {
 	ch := Debounce(p.ctx, p.ch, d)
-	return From(p.ctx, ch)
+	return then(p, "debounce", ch)
 }
 
 // FixedInterval emits values at a fixed rate, queueing them without dropping.
@@ -238,7 +442,51 @@ func (p *Pipeline[T]) Debounce(d time.Duration) *Pipeline[T] {
 //	pipeline.FixedInterval(100 * time.Millisecond)  // 1 value every 100ms
 func (p *Pipeline[T]) FixedInterval(d time.Duration) *Pipeline[T] {
 	ch := FixedInterval(p.ctx, p.ch, d)
-	return From(p.ctx, ch)
+	return then(p, "fixedInterval", ch)
+}
+
+// Buffer inserts a decoupling buffer of the given size; policy decides what happens
+// when it is full (Block, DropNewest or DropOldest).
+//
+// Example:
+//
+//	pipeline.Buffer(100, chankit.DropOldest).Map(render) // a slow renderer only sees recent values
+func (p *Pipeline[T]) Buffer(size int, policy OverflowPolicy) *Pipeline[T] {
+	ch, _ := Buffer(p.ctx, p.ch, size, policy)
+	return then(p, "buffer", ch)
+}
+
+// Every emits the first value of every group of n values, dropping the rest.
+// This is a deterministic, count-based counterpart to Throttle.
+//
+// Example:
+//
+//	pipeline.Every(3)  // [1, 2, 3, 4, 5, 6, 7] -> [1, 4, 7]
+func (p *Pipeline[T]) Every(n int) *Pipeline[T] {
+	ch := SampleN(p.ctx, p.ch, n)
+	return then(p, "every", ch)
+}
+
+// Nth emits every nth value (the nth, 2nth, 3nth, ...), dropping the rest.
+// Unlike Every, which keeps the first value of each group, Nth keeps the last.
+//
+// Example:
+//
+//	pipeline.Nth(3)  // [1, 2, 3, 4, 5, 6, 7] -> [3, 6]
+func (p *Pipeline[T]) Nth(n int) *Pipeline[T] {
+	ch := SampleN(p.ctx, Skip(p.ctx, p.ch, n-1), n)
+	return then(p, "nth", ch)
+}
+
+// OnIdle passes values through but calls fn whenever no value has flowed for d.
+// The stream keeps going; this is for alerting on stalls without terminating.
+//
+// Example:
+//
+//	pipeline.OnIdle(5*time.Second, func() { log.Println("stalled") })
+func (p *Pipeline[T]) OnIdle(d time.Duration, fn func()) *Pipeline[T] {
+	ch := OnIdle(p.ctx, p.ch, d, fn)
+	return then(p, "onIdle", ch)
 }
 
 // Batch groups values into slices based on size or timeout.
@@ -254,6 +502,17 @@ func (p *Pipeline[T]) Batch(size int, timeout time.Duration) <-chan []T {
 	return Batch(p.ctx, p.ch, size, timeout)
 }
 
+// ChunkPipeline groups values into fixed-size slices while staying in the fluent chain.
+// The final partial chunk is included.
+//
+// Example:
+//
+//	sums := MapTo(ChunkPipeline(pipeline, 3), func(chunk []int) int { return sum(chunk) })
+func ChunkPipeline[T any](p *Pipeline[T], size int) *Pipeline[[]T] {
+	ch := Chunk(p.ctx, p.ch, size)
+	return then(p, "chunk", ch)
+}
+
 // ============================================================================
 // Side Effect Methods
 // ============================================================================
@@ -266,7 +525,29 @@ func (p *Pipeline[T]) Batch(size int, timeout time.Duration) <-chan []T {
 //	pipeline.Tap(func(x int) { fmt.Printf("Value: %d\n", x) })
 func (p *Pipeline[T]) Tap(fn func(T)) *Pipeline[T] {
 	ch := Tap(p.ctx, p.ch, fn)
-	return From(p.ctx, ch)
+	return then(p, "tap", ch)
+}
+
+// TapIndexed observes values like Tap, also passing the zero-based position of each value.
+//
+// Example:
+//
+//	pipeline.TapIndexed(func(i int, _ Order) { if i%1000 == 0 { log.Printf("item %d", i) } })
+func (p *Pipeline[T]) TapIndexed(fn func(i int, v T)) *Pipeline[T] {
+	ch := TapIndexed(p.ctx, p.ch, fn)
+	return then(p, "tapIndexed", ch)
+}
+
+// Inspect writes each value to w, preceded by prefix and followed by a newline,
+// and passes values through unchanged. It is a Tap specialized for debugging output.
+//
+// Example:
+//
+//	pipeline.Inspect(os.Stderr, "after filter: ") // "after filter: 2", "after filter: 4", ...
+func (p *Pipeline[T]) Inspect(w io.Writer, prefix string) *Pipeline[T] {
+	return p.Tap(func(v T) {
+		fmt.Fprintf(w, "%s%v\n", prefix, v)
+	}).Named("inspect")
 }
 
 // ============================================================================
@@ -283,7 +564,27 @@ func (p *Pipeline[T]) Tap(fn func(T)) *Pipeline[T] {
 func (p *Pipeline[T]) Merge(channels ...<-chan T) *Pipeline[T] {
 	allChannels := append([]<-chan T{p.ch}, channels...)
 	ch := Merge(p.ctx, allChannels...)
-	return From(p.ctx, ch)
+	return then(p, "merge", ch)
+}
+
+// StartWith emits the given values before the values of this pipeline.
+//
+// Example:
+//
+//	pipeline.StartWith(0) // [1, 2, 3] -> [0, 1, 2, 3]
+func (p *Pipeline[T]) StartWith(values ...T) *Pipeline[T] {
+	ch := StartWith(p.ctx, p.ch, values...)
+	return then(p, "startWith", ch)
+}
+
+// EndWith emits the given values after this pipeline completes.
+//
+// Example:
+//
+//	pipeline.EndWith(-1) // [1, 2, 3] -> [1, 2, 3, -1]
+func (p *Pipeline[T]) EndWith(values ...T) *Pipeline[T] {
+	ch := EndWith(p.ctx, p.ch, values...)
+	return then(p, "endWith", ch)
 }
 
 // ZipWith combines this pipeline with another channel into pairs.
@@ -299,7 +600,25 @@ func ZipWith[T, R any](p *Pipeline[T], other <-chan R) *Pipeline[struct {
 	Second R
 }] {
 	ch := Zip(p.ctx, p.ch, other)
-	return From(p.ctx, ch)
+	return then(p, "zipWith", ch)
+}
+
+// Zip3With combines this pipeline with two other channels into typed triples.
+// Returns a pipeline of structs containing First, Second and Third fields.
+//
+// Example:
+//
+//	ids := chankit.Of(ctx, 1, 2)
+//	names := chankit.Of(ctx, "ann", "bob")
+//	ages := chankit.Of(ctx, 31, 27)
+//	people := Zip3With(ids, names.Chan(), ages.Chan())
+func Zip3With[A, B, C any](p *Pipeline[A], b <-chan B, c <-chan C) *Pipeline[struct {
+	First  A
+	Second B
+	Third  C
+}] {
+	ch := Zip3(p.ctx, p.ch, b, c)
+	return then(p, "zip3With", ch)
 }
 
 // ============================================================================
@@ -335,6 +654,66 @@ func ReduceTo[T, R any](p *Pipeline[T], fn func(acc R, val T) R, initial R) R {
 	return Reduce(p.ctx, p.ch, fn, initial)
 }
 
+// Collect folds the pipeline into a container built by supplier, adding each value with
+// accumulate. It generalizes ReduceTo for building slices, maps or custom structs, since
+// supplier creates a fresh container on every call instead of sharing one initial value.
+// This is a blocking operation; if the context is cancelled, the partial container is returned.
+//
+// Example:
+//
+//	byID := Collect(users,
+//	    func() map[int]User { return make(map[int]User) },
+//	    func(m map[int]User, u User) map[int]User { m[u.ID] = u; return m })
+func Collect[T, A any](p *Pipeline[T], supplier func() A, accumulate func(A, T) A) A {
+	return Reduce(p.ctx, p.ch, accumulate, supplier())
+}
+
+// Reverse collects all values and returns them in reverse order.
+// This necessarily buffers the whole stream in memory, so it is only suitable for
+// bounded inputs. This is a blocking operation.
+//
+// Example:
+//
+//	reversed := Reverse(chankit.Of(ctx, 1, 2, 3))  // [3, 2, 1]
+func Reverse[T any](p *Pipeline[T]) []T {
+	values := p.ToSlice()
+	slices.Reverse(values)
+	return values
+}
+
+// FrequenciesPipeline counts how many times each distinct value occurs in the pipeline.
+// It is a free function because the element type must be comparable, and carries the
+// Pipeline suffix to avoid clashing with the Frequencies operator. This is a blocking operation.
+//
+// Example:
+//
+//	counts := FrequenciesPipeline(MapTo(orders, Order.Status))  // orders per status
+func FrequenciesPipeline[T comparable](p *Pipeline[T]) map[T]int {
+	return Frequencies(p.ctx, p.ch)
+}
+
+// MinOf returns the smallest value in the pipeline, and false if it produced no values.
+// It works for any ordered type, including strings. On ties the first value wins.
+// This is a blocking operation.
+//
+// Example:
+//
+//	first, ok := MinOf(chankit.Of(ctx, "pear", "apple", "fig"))  // "apple", true
+func MinOf[T cmp.Ordered](p *Pipeline[T]) (T, bool) {
+	return MinBy(p.ctx, p.ch, func(v T) T { return v })
+}
+
+// MaxOf returns the largest value in the pipeline, and false if it produced no values.
+// It works for any ordered type, including strings. On ties the first value wins.
+// This is a blocking operation.
+//
+// Example:
+//
+//	last, ok := MaxOf(chankit.Of(ctx, "pear", "apple", "fig"))  // "pear", true
+func MaxOf[T cmp.Ordered](p *Pipeline[T]) (T, bool) {
+	return MaxBy(p.ctx, p.ch, func(v T) T { return v })
+}
+
 // ForEach executes a function for each value in the pipeline.
 // This is a blocking operation.
 //
@@ -351,6 +730,72 @@ func (p *Pipeline[T]) ForEach(fn func(T)) {
 	}
 }
 
+// Do executes fn for each value in the pipeline and returns the first error it reports.
+// On error, the remaining values are drained in the background so upstream is not blocked.
+// Returns nil on clean completion, or the context error if the context is cancelled.
+// This is a blocking operation.
+//
+// Example:
+//
+//	err := pipeline.Do(func(r Row) error { return db.Insert(r) })
+func (p *Pipeline[T]) Do(fn func(T) error) error {
+	for {
+		val, ok := recieve(p.ctx, p.ch)
+		if !ok {
+			return p.ctx.Err()
+		}
+		if err := fn(val); err != nil {
+			go drain(p.ch)
+			return err
+		}
+	}
+}
+
+// ForEachChunk executes a function for each chunk of up to size values.
+// Full chunks are delivered as they fill up; the final partial chunk is delivered
+// when the pipeline completes or the context is cancelled.
+// This is a blocking operation.
+//
+// Example:
+//
+//	pipeline.ForEachChunk(100, func(rows []Row) { db.InsertBatch(rows) })
+func (p *Pipeline[T]) ForEachChunk(size int, fn func([]T)) {
+	size = max(size, 1)
+	chunk := make([]T, 0, size)
+	for {
+		val, ok := recieve(p.ctx, p.ch)
+		if !ok {
+			if len(chunk) > 0 {
+				fn(chunk)
+			}
+			return
+		}
+
+		chunk = append(chunk, val)
+		if len(chunk) == size {
+			fn(chunk)
+			chunk = make([]T, 0, size)
+		}
+	}
+}
+
+// RunAsync consumes the pipeline in a background goroutine, calling fn for each value.
+// It returns immediately with a done channel that closes when the stream finishes
+// or the context is cancelled.
+//
+// Example:
+//
+//	done := pipeline.RunAsync(func(x int) { fmt.Println(x) })
+//	<-done
+func (p *Pipeline[T]) RunAsync(fn func(T)) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		p.ForEach(fn)
+	}()
+	return done
+}
+
 // Count returns the number of values in the pipeline.
 // This is a blocking operation.
 //
@@ -368,6 +813,25 @@ func (p *Pipeline[T]) Count() int {
 	}
 }
 
+// CountWhere returns the number of values that satisfy the predicate.
+// This is a blocking operation; on context cancellation the partial count is returned.
+//
+// Example:
+//
+//	evens := pipeline.CountWhere(func(x int) bool { return x%2 == 0 })
+func (p *Pipeline[T]) CountWhere(pred func(T) bool) int {
+	count := 0
+	for {
+		val, ok := recieve(p.ctx, p.ch)
+		if !ok {
+			return count
+		}
+		if pred(val) {
+			count++
+		}
+	}
+}
+
 // Chan returns the underlying channel.
 // This allows you to use the pipeline with other channel operations.
 //
@@ -381,6 +845,19 @@ func (p *Pipeline[T]) Chan() <-chan T {
 	return p.ch
 }
 
+// ToBufferedChan forwards the pipeline through a new channel with the given buffer size.
+// This decouples the consumer from upstream pacing, regardless of how upstream was buffered.
+//
+// Example:
+//
+//	ch := pipeline.ToBufferedChan(100)
+//	for val := range ch {
+//	    fmt.Println(val)
+//	}
+func (p *Pipeline[T]) ToBufferedChan(size int) <-chan T {
+	return Rebuffer(p.ctx, p.ch, size)
+}
+
 // ============================================================================
 // Utility Methods
 // ============================================================================
@@ -397,6 +874,47 @@ func (p *Pipeline[T]) WithBuffer(size int) *Pipeline[T] {
 	return p
 }
 
+// Stages returns the names of the stages chained so far, starting with the source.
+// This is useful for debugging and introspecting large chains.
+//
+// Example:
+//
+//	chankit.FromSlice(ctx, data).Filter(isValid).Take(10).Stages()
+//	// ["fromSlice", "filter", "take"]
+func (p *Pipeline[T]) Stages() []string {
+	return slices.Clone(p.stages)
+}
+
+// Named renames the most recent stage, so that Stages reports a domain-specific name.
+// On a pipeline without stages, the name is recorded as the first stage.
+//
+// Example:
+//
+//	pipeline.Filter(isValid).Named("validate").Take(10).Stages()
+//	// [..., "validate", "take"]
+func (p *Pipeline[T]) Named(name string) *Pipeline[T] {
+	stages := slices.Clone(p.stages)
+	if len(stages) == 0 {
+		stages = append(stages, name)
+	} else {
+		stages[len(stages)-1] = name
+	}
+	return &Pipeline[T]{
+		ctx:    p.ctx,
+		ch:     p.ch,
+		stages: stages,
+	}
+}
+
+// then wraps ch in a new Pipeline that carries p's stage history followed by name.
+func then[T, R any](p *Pipeline[T], name string, ch <-chan R) *Pipeline[R] {
+	return &Pipeline[R]{
+		ctx:    p.ctx,
+		ch:     ch,
+		stages: append(slices.Clone(p.stages), name),
+	}
+}
+
 // ============================================================================
 // Convenience Aliases (LINQ-style)
 // ============================================================================
@@ -419,6 +937,25 @@ func (p *Pipeline[T]) Select(fn func(T) any) *Pipeline[any] {
 	return p.Map(fn)
 }
 
+// SelectMany is an alias for FlatMap (LINQ-style naming).
+//
+// Example:
+//
+//	pipeline.SelectMany(func(x int) <-chan int { return expand(x) })
+func (p *Pipeline[T]) SelectMany(fn func(T) <-chan T) *Pipeline[T] {
+	return p.FlatMap(fn)
+}
+
+// Aggregate is an alias for Reduce (LINQ-style naming).
+// This is a blocking operation.
+//
+// Example:
+//
+//	sum := pipeline.Aggregate(func(acc, x int) int { return acc + x }, 0)
+func (p *Pipeline[T]) Aggregate(fn func(acc, val T) T, seed T) T {
+	return p.Reduce(fn, seed)
+}
+
 // First returns the first value in the pipeline.
 // This is a blocking operation.
 //
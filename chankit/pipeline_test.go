@@ -1,9 +1,13 @@
 package chankit
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 )
@@ -51,6 +55,21 @@ func TestFromSlice(t *testing.T) {
 	}
 }
 
+func TestOf(t *testing.T) {
+	ctx := context.Background()
+
+	result := Of(ctx, 1, 2, 3, 4, 5).ToSlice()
+	expected := FromSlice(ctx, []int{1, 2, 3, 4, 5}).ToSlice()
+
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	if empty := Of[int](ctx).ToSlice(); len(empty) != 0 {
+		t.Errorf("Expected empty result, got %v", empty)
+	}
+}
+
 // ============================================================================
 // Generator Method Tests
 // ============================================================================
@@ -115,6 +134,53 @@ func TestPipelineGenerate(t *testing.T) {
 	}
 }
 
+func TestPipelineRetry(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("connection reset")
+
+	calls := 0
+	factory := func() *Pipeline[Result[int]] {
+		calls++
+		if calls <= 2 {
+			return Of(ctx, Result[int]{Value: 1}, Result[int]{Err: boom})
+		}
+		return Of(ctx, Result[int]{Value: 1}, Result[int]{Value: 2}, Result[int]{Value: 3})
+	}
+
+	var errs []error
+	result := RetryPipeline(ctx, factory, 3, time.Millisecond, WithErrorSink[int](func(err error) {
+		errs = append(errs, err)
+	})).ToSlice()
+
+	// Values before each failure are delivered again on retry.
+	expected := []int{1, 1, 1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 factory calls, got %d", calls)
+	}
+	if len(errs) != 2 {
+		t.Errorf("Expected 2 errors, got %d", len(errs))
+	}
+}
+
+func TestPipelineFromChannels(t *testing.T) {
+	ctx := context.Background()
+
+	result := FromChannels(ctx,
+		SliceToChan(ctx, []int{1, 2, 3}),
+		SliceToChan(ctx, []int{10, 20}),
+		SliceToChan(ctx, []int{100}),
+	).ToSlice()
+
+	sort.Ints(result)
+	expected := []int{1, 2, 3, 10, 20, 100}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
 // ============================================================================
 // Transformation Method Tests
 // ============================================================================
@@ -146,6 +212,20 @@ func TestPipelineMapTo(t *testing.T) {
 	}
 }
 
+func TestPipelineMapSame(t *testing.T) {
+	ctx := context.Background()
+
+	result := FromSlice(ctx, []int{1, 2, 3, 4, 5}).
+		MapSame(func(x int) int { return x * 2 }).
+		Filter(func(x int) bool { return x > 2 }).
+		ToSlice()
+
+	expected := []int{4, 6, 8, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
 func TestPipelineFilter(t *testing.T) {
 	ctx := context.Background()
 
@@ -180,6 +260,155 @@ func TestPipelineFlatMap(t *testing.T) {
 	}
 }
 
+func TestPipelineEnumerate(t *testing.T) {
+	ctx := context.Background()
+
+	var indices []int
+	var values []string
+	for item := range FromSlice(ctx, []string{"a", "b", "c"}).Enumerate() {
+		indices = append(indices, item.Index)
+		values = append(values, item.Value)
+	}
+
+	if !reflect.DeepEqual(indices, []int{0, 1, 2}) {
+		t.Errorf("Expected %v, got %v", []int{0, 1, 2}, indices)
+	}
+	if !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Errorf("Expected %v, got %v", []string{"a", "b", "c"}, values)
+	}
+}
+
+func TestPipelineDistinctBy(t *testing.T) {
+	ctx := context.Background()
+
+	type user struct {
+		ID   int
+		Name string
+		Tags []string
+	}
+
+	users := []user{
+		{1, "alice", []string{"admin"}},
+		{2, "bob", nil},
+		{1, "alice-dup", nil},
+		{3, "carol", []string{"dev"}},
+		{2, "bob-dup", nil},
+	}
+
+	result := DistinctByPipeline(FromSlice(ctx, users), func(u user) int { return u.ID }).
+		ToSlice()
+
+	expected := []user{users[0], users[1], users[3]}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineDistinctWindow(t *testing.T) {
+	ctx := context.Background()
+
+	result := DistinctWindowPipeline(FromSlice(ctx, []int{1, 2, 1, 3, 2}), time.Second).
+		ToSlice()
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineDistinctLastN(t *testing.T) {
+	ctx := context.Background()
+
+	result := DistinctLastNPipeline(FromSlice(ctx, []int{1, 2, 3, 1, 4, 1}), 2).
+		ToSlice()
+
+	expected := []int{1, 2, 3, 1, 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineMergeMapTo(t *testing.T) {
+	ctx := context.Background()
+
+	result := MergeMapTo(FromSlice(ctx, []int{1, 2, 3}), 2, func(x int) <-chan string {
+		return SliceToChan(ctx, []string{fmt.Sprint(x), fmt.Sprint(x * 10)})
+	}).ToSlice()
+
+	sort.Strings(result)
+	expected := []string{"1", "10", "2", "20", "3", "30"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineConcatMapTo(t *testing.T) {
+	ctx := context.Background()
+
+	result := ConcatMapTo(FromSlice(ctx, []int{1, 2, 3}), func(x int) <-chan string {
+		return SliceToChan(ctx, []string{fmt.Sprint(x), fmt.Sprint(x * 10)})
+	}).ToSlice()
+
+	expected := []string{"1", "10", "2", "20", "3", "30"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineSwitchMapTo(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+		ch <- 1
+		ch <- 2
+	}()
+
+	result := SwitchMapTo(From(ctx, ch), func(x int) <-chan string {
+		out := make(chan string)
+		go func() {
+			defer close(out)
+			time.Sleep(20 * time.Millisecond)
+			select {
+			case out <- fmt.Sprint(x):
+			case <-time.After(time.Second):
+			}
+		}()
+		return out
+	}).ToSlice()
+
+	expected := []string{"2"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineGroupByKey(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sums := make(map[int]int)
+
+	for g := range GroupByKey(RangePipeline(ctx, 1, 10, 1), func(x int) int { return x % 3 }) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sum := g.Pipeline.Reduce(func(acc, x int) int { return acc + x }, 0)
+			mu.Lock()
+			sums[g.Key] = sum
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	expected := map[int]int{1: 1 + 4 + 7, 2: 2 + 5 + 8, 0: 3 + 6 + 9}
+	if !reflect.DeepEqual(sums, expected) {
+		t.Errorf("Expected %v, got %v", expected, sums)
+	}
+}
+
 // ============================================================================
 // Selection Method Tests
 // ============================================================================
@@ -236,6 +465,54 @@ func TestPipelineSkipWhile(t *testing.T) {
 	}
 }
 
+func TestPipelineTakeLast(t *testing.T) {
+	ctx := context.Background()
+
+	result := FromSlice(ctx, []int{1, 2, 3, 4, 5}).
+		TakeLast(2).
+		ToSlice()
+
+	expected := []int{4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineSlice(t *testing.T) {
+	ctx := context.Background()
+
+	result := RangePipeline(ctx, 1, 21, 1).
+		Slice(3, 4).
+		ToSlice()
+
+	expected := []int{4, 5, 6, 7}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelinePage(t *testing.T) {
+	ctx := context.Background()
+
+	result := RangePipeline(ctx, 1, 21, 1).
+		Page(2, 5).
+		ToSlice()
+
+	expected := []int{6, 7, 8, 9, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+
+	last := RangePipeline(ctx, 1, 21, 1).
+		Page(4, 6).
+		ToSlice()
+
+	expected = []int{19, 20}
+	if !reflect.DeepEqual(last, expected) {
+		t.Errorf("Expected %v, got %v", expected, last)
+	}
+}
+
 // ============================================================================
 // Flow Control Method Tests
 // ============================================================================
@@ -313,6 +590,87 @@ func TestPipelineFixedInterval(t *testing.T) {
 	}
 }
 
+func TestPipelineBufferDropOldest(t *testing.T) {
+	ctx := context.Background()
+	in := make(chan int)
+	p := From(ctx, in).Buffer(3, DropOldest)
+
+	// Nobody reads until the producer is done, so only the newest values survive.
+	for i := 1; i <= 10; i++ {
+		in <- i
+	}
+	close(in)
+
+	result := p.MapSame(func(x int) int { return x * 10 }).ToSlice()
+
+	expected := []int{80, 90, 100}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineBufferBlock(t *testing.T) {
+	ctx := context.Background()
+
+	result := RangePipeline(ctx, 1, 6, 1).Buffer(2, Block).ToSlice()
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineEvery(t *testing.T) {
+	ctx := context.Background()
+
+	result := RangePipeline(ctx, 1, 11, 1).
+		Every(3).
+		ToSlice()
+
+	expected := []int{1, 4, 7, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineNth(t *testing.T) {
+	ctx := context.Background()
+
+	result := RangePipeline(ctx, 1, 11, 1).
+		Nth(3).
+		ToSlice()
+
+	expected := []int{3, 6, 9}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineOnIdle(t *testing.T) {
+	ctx := context.Background()
+	ch := make(chan int)
+	idle := make(chan struct{}, 10)
+
+	go func() {
+		ch <- 1
+		time.Sleep(60 * time.Millisecond)
+		ch <- 2
+		close(ch)
+	}()
+
+	result := From(ctx, ch).
+		OnIdle(20*time.Millisecond, func() { idle <- struct{}{} }).
+		ToSlice()
+
+	expected := []int{1, 2}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+	if len(idle) == 0 {
+		t.Error("Expected idle callback to fire during the gap")
+	}
+}
+
 func TestPipelineBatch(t *testing.T) {
 	ctx := context.Background()
 
@@ -357,6 +715,24 @@ func TestPipelineBatchTimeout(t *testing.T) {
 	}
 }
 
+func TestPipelineChunk(t *testing.T) {
+	ctx := context.Background()
+
+	chunks := ChunkPipeline(RangePipeline(ctx, 1, 8, 1), 3)
+	result := MapTo(chunks, func(chunk []int) int {
+		sum := 0
+		for _, v := range chunk {
+			sum += v
+		}
+		return sum
+	}).ToSlice()
+
+	expected := []int{6, 15, 7}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
 // ============================================================================
 // Side Effect Method Tests
 // ============================================================================
@@ -381,6 +757,45 @@ func TestPipelineTap(t *testing.T) {
 	}
 }
 
+func TestPipelineTapIndexed(t *testing.T) {
+	ctx := context.Background()
+	var indexes []int
+
+	result := FromSlice(ctx, []int{10, 20, 30}).
+		TapIndexed(func(i int, _ int) { indexes = append(indexes, i) }).
+		ToSlice()
+
+	expectedIndexes := []int{0, 1, 2}
+	if !reflect.DeepEqual(indexes, expectedIndexes) {
+		t.Errorf("TapIndexed observed %v, expected %v", indexes, expectedIndexes)
+	}
+
+	expected := []int{10, 20, 30}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineInspect(t *testing.T) {
+	ctx := context.Background()
+	var buf bytes.Buffer
+
+	result := FromSlice(ctx, []int{1, 2, 3, 4}).
+		Filter(func(x int) bool { return x%2 == 0 }).
+		Inspect(&buf, "even: ").
+		ToSlice()
+
+	expectedResult := []int{2, 4}
+	if !reflect.DeepEqual(result, expectedResult) {
+		t.Errorf("Expected %v, got %v", expectedResult, result)
+	}
+
+	expectedOutput := "even: 2\neven: 4\n"
+	if buf.String() != expectedOutput {
+		t.Errorf("Expected %q, got %q", expectedOutput, buf.String())
+	}
+}
+
 // ============================================================================
 // Combining Method Tests
 // ============================================================================
@@ -442,6 +857,61 @@ func TestPipelineZip(t *testing.T) {
 	}
 }
 
+func TestPipelineStartWithEndWith(t *testing.T) {
+	ctx := context.Background()
+
+	result := FromSlice(ctx, []int{1, 2, 3, 4, 5}).
+		Filter(func(x int) bool { return x%2 == 1 }).
+		StartWith(0).
+		MapSame(func(x int) int { return x * 10 }).
+		EndWith(-1).
+		ToSlice()
+
+	expected := []int{0, 10, 30, 50, -1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineStartWithBeforeFilter(t *testing.T) {
+	ctx := context.Background()
+
+	// Seeded values flow through later stages like any other value.
+	result := FromSlice(ctx, []int{3, 4}).
+		StartWith(1, 2).
+		EndWith(5, 6).
+		Filter(func(x int) bool { return x%2 == 0 }).
+		ToSlice()
+
+	expected := []int{2, 4, 6}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineZip3With(t *testing.T) {
+	ctx := context.Background()
+
+	ids := Of(ctx, 1, 2, 3)
+	names := Of(ctx, "ann", "bob", "cy")
+	ages := Of(ctx, 31, 27, 45)
+
+	result := Zip3With(ids, names.Chan(), ages.Chan()).ToSlice()
+
+	expected := []struct {
+		First  int
+		Second string
+		Third  int
+	}{
+		{1, "ann", 31},
+		{2, "bob", 27},
+		{3, "cy", 45},
+	}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
 // ============================================================================
 // Terminal Operation Tests
 // ============================================================================
@@ -490,6 +960,99 @@ func TestPipelineReduceTo(t *testing.T) {
 	}
 }
 
+func TestPipelineCollect(t *testing.T) {
+	ctx := context.Background()
+
+	type runningStats struct {
+		count    int
+		sum      int
+		min, max int
+	}
+
+	result := Collect(
+		FromSlice(ctx, []int{4, 9, 1, 6}),
+		func() *runningStats { return &runningStats{} },
+		func(s *runningStats, x int) *runningStats {
+			if s.count == 0 || x < s.min {
+				s.min = x
+			}
+			if s.count == 0 || x > s.max {
+				s.max = x
+			}
+			s.count++
+			s.sum += x
+			return s
+		},
+	)
+
+	expected := runningStats{count: 4, sum: 20, min: 1, max: 9}
+	if *result != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *result)
+	}
+}
+
+func TestPipelineCollectCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := Collect(
+		From(ctx, make(chan int)),
+		func() []int { return []int{} },
+		func(acc []int, x int) []int { return append(acc, x) },
+	)
+
+	if result == nil || len(result) != 0 {
+		t.Errorf("Expected the empty supplied container, got %v", result)
+	}
+}
+
+func TestPipelineReverse(t *testing.T) {
+	ctx := context.Background()
+
+	result := Reverse(Of(ctx, 1, 2, 3))
+
+	expected := []int{3, 2, 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineFrequencies(t *testing.T) {
+	ctx := context.Background()
+
+	parities := MapTo(RangePipeline(ctx, 1, 8, 1), func(x int) string {
+		if x%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	})
+	result := FrequenciesPipeline(parities)
+
+	expected := map[string]int{"even": 3, "odd": 4}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineMinOfMaxOf(t *testing.T) {
+	ctx := context.Background()
+	words := []string{"pear", "apple", "fig", "plum"}
+
+	minWord, ok := MinOf(FromSlice(ctx, words))
+	if !ok || minWord != "apple" {
+		t.Errorf("Expected apple, got %q (ok=%v)", minWord, ok)
+	}
+
+	maxWord, ok := MaxOf(FromSlice(ctx, words))
+	if !ok || maxWord != "plum" {
+		t.Errorf("Expected plum, got %q (ok=%v)", maxWord, ok)
+	}
+
+	if _, ok := MinOf(FromSlice(ctx, []string{})); ok {
+		t.Error("Expected ok=false for an empty pipeline")
+	}
+}
+
 func TestPipelineForEach(t *testing.T) {
 	ctx := context.Background()
 	var result []int
@@ -503,6 +1066,140 @@ func TestPipelineForEach(t *testing.T) {
 	}
 }
 
+func TestPipelineDo(t *testing.T) {
+	ctx := context.Background()
+	var seen []int
+
+	err := FromSlice(ctx, []int{1, 2, 3}).Do(func(x int) error {
+		seen = append(seen, x)
+		return nil
+	})
+
+	if err != nil {
+		t.Errorf("Expected nil error, got %v", err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2, 3}, seen)
+	}
+}
+
+func TestPipelineDoError(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("write failed")
+	var seen []int
+
+	in := make(chan int)
+	producerDone := make(chan struct{})
+	go func() {
+		defer close(producerDone)
+		defer close(in)
+		for i := 1; i <= 10; i++ {
+			in <- i
+		}
+	}()
+
+	err := From(ctx, in).Do(func(x int) error {
+		seen = append(seen, x)
+		if x == 3 {
+			return boom
+		}
+		return nil
+	})
+
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected %v, got %v", boom, err)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Errorf("Expected %v, got %v", []int{1, 2, 3}, seen)
+	}
+
+	select {
+	case <-producerDone:
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("Expected the rest of the stream to be drained")
+	}
+}
+
+func TestPipelineDoCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := NewPipeline[int](ctx).Repeat(1).Do(func(int) error {
+		cancel()
+		return nil
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected %v, got %v", context.Canceled, err)
+	}
+}
+
+func TestPipelineForEachChunk(t *testing.T) {
+	ctx := context.Background()
+	var chunks [][]int
+
+	RangePipeline(ctx, 1, 8, 1).
+		ForEachChunk(3, func(chunk []int) { chunks = append(chunks, chunk) })
+
+	expected := [][]int{{1, 2, 3}, {4, 5, 6}, {7}}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Expected %v, got %v", expected, chunks)
+	}
+}
+
+func TestPipelineForEachChunkCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+
+	go func() {
+		ch <- 1
+		ch <- 2
+		cancel()
+	}()
+
+	var chunks [][]int
+	From(ctx, ch).ForEachChunk(5, func(chunk []int) { chunks = append(chunks, chunk) })
+
+	expected := [][]int{{1, 2}}
+	if !reflect.DeepEqual(chunks, expected) {
+		t.Errorf("Expected %v, got %v", expected, chunks)
+	}
+}
+
+func TestPipelineRunAsync(t *testing.T) {
+	ctx := context.Background()
+	var result []int
+
+	done := FromSlice(ctx, []int{1, 2, 3, 4, 5}).
+		RunAsync(func(x int) { result = append(result, x*2) })
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunAsync did not complete")
+	}
+
+	expected := []int{2, 4, 6, 8, 10}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineRunAsyncCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := NewPipeline[int](ctx).
+		Repeat(1).
+		RunAsync(func(int) {})
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunAsync did not stop after cancellation")
+	}
+}
+
 func TestPipelineCount(t *testing.T) {
 	ctx := context.Background()
 
@@ -516,6 +1213,18 @@ func TestPipelineCount(t *testing.T) {
 	}
 }
 
+func TestPipelineCountWhere(t *testing.T) {
+	ctx := context.Background()
+
+	count := RangePipeline(ctx, 1, 11, 1).
+		CountWhere(func(x int) bool { return x%2 == 0 })
+
+	expected := 5
+	if count != expected {
+		t.Errorf("Expected %d, got %d", expected, count)
+	}
+}
+
 func TestPipelineChan(t *testing.T) {
 	ctx := context.Background()
 
@@ -532,6 +1241,80 @@ func TestPipelineChan(t *testing.T) {
 	}
 }
 
+func TestPipelineToBufferedChan(t *testing.T) {
+	ctx := context.Background()
+
+	ch := FromSlice(ctx, []int{1, 2, 3, 4, 5}).ToBufferedChan(3)
+
+	if cap(ch) != 3 {
+		t.Fatalf("Expected buffer capacity 3, got %d", cap(ch))
+	}
+
+	// The forwarding goroutine should fill the buffer without a reader.
+	deadline := time.Now().Add(time.Second)
+	for len(ch) < 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if len(ch) != 3 {
+		t.Errorf("Expected 3 buffered values, got %d", len(ch))
+	}
+
+	var result []int
+	for val := range ch {
+		result = append(result, val)
+	}
+
+	expected := []int{1, 2, 3, 4, 5}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Expected %v, got %v", expected, result)
+	}
+}
+
+func TestPipelineStages(t *testing.T) {
+	ctx := context.Background()
+
+	p := FromSlice(ctx, []int{1, 2, 3, 4, 5}).
+		Filter(func(x int) bool { return x%2 == 1 }).
+		MapSame(func(x int) int { return x * 10 }).
+		Take(2)
+	asStrings := MapTo(p, func(x int) string { return fmt.Sprint(x) })
+
+	expected := []string{"fromSlice", "filter", "mapSame", "take", "mapTo"}
+	if !reflect.DeepEqual(asStrings.Stages(), expected) {
+		t.Errorf("Expected %v, got %v", expected, asStrings.Stages())
+	}
+
+	// Earlier pipelines in the chain are unaffected by later stages.
+	expectedPrefix := []string{"fromSlice", "filter", "mapSame", "take"}
+	if !reflect.DeepEqual(p.Stages(), expectedPrefix) {
+		t.Errorf("Expected %v, got %v", expectedPrefix, p.Stages())
+	}
+
+	result := asStrings.ToSlice()
+	expectedResult := []string{"10", "30"}
+	if !reflect.DeepEqual(result, expectedResult) {
+		t.Errorf("Expected %v, got %v", expectedResult, result)
+	}
+}
+
+func TestPipelineNamed(t *testing.T) {
+	ctx := context.Background()
+
+	p := Of(ctx, 1, 2, 3).
+		Filter(func(x int) bool { return x > 1 }).Named("dropFirst").
+		Tap(func(int) {})
+
+	expected := []string{"of", "dropFirst", "tap"}
+	if !reflect.DeepEqual(p.Stages(), expected) {
+		t.Errorf("Expected %v, got %v", expected, p.Stages())
+	}
+
+	empty := NewPipeline[int](ctx).Named("source")
+	if !reflect.DeepEqual(empty.Stages(), []string{"source"}) {
+		t.Errorf("Expected [source], got %v", empty.Stages())
+	}
+}
+
 // ============================================================================
 // LINQ-Style Alias Tests
 // ============================================================================
@@ -562,6 +1345,34 @@ func TestPipelineSelect(t *testing.T) {
 	}
 }
 
+func TestPipelineSelectMany(t *testing.T) {
+	ctx := context.Background()
+	expand := func(x int) <-chan int {
+		return SliceToChan(ctx, []int{x, x * 10})
+	}
+
+	viaAlias := FromSlice(ctx, []int{1, 2, 3}).SelectMany(expand).ToSlice()
+	viaFlatMap := FromSlice(ctx, []int{1, 2, 3}).FlatMap(expand).ToSlice()
+
+	sort.Ints(viaAlias)
+	sort.Ints(viaFlatMap)
+	if !reflect.DeepEqual(viaAlias, viaFlatMap) {
+		t.Errorf("Expected %v, got %v", viaFlatMap, viaAlias)
+	}
+}
+
+func TestPipelineAggregate(t *testing.T) {
+	ctx := context.Background()
+	sum := func(acc, x int) int { return acc + x }
+
+	aggregated := FromSlice(ctx, []int{1, 2, 3, 4, 5}).Aggregate(sum, 10)
+	reduced := FromSlice(ctx, []int{1, 2, 3, 4, 5}).Reduce(sum, 10)
+
+	if aggregated != reduced || aggregated != 25 {
+		t.Errorf("Expected %d, got %d", reduced, aggregated)
+	}
+}
+
 func TestPipelineFirst(t *testing.T) {
 	ctx := context.Background()
 
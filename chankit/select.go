@@ -1,6 +1,9 @@
 package chankit
 
-import "context"
+import (
+	"context"
+	"math/rand/v2"
+)
 
 // Take emits the first 'count' values from the input channel, then closes.
 // This is useful for limiting the number of items processed from a potentially infinite stream.
@@ -146,3 +149,269 @@ func SkipWhile[T any](ctx context.Context, in <-chan T, predicate func(T) bool,
 
 	return outChan
 }
+
+// TakeLast emits the last 'count' values from the input channel once the input closes.
+// Values are held in a ring buffer of size 'count', so memory is bounded regardless of stream length.
+// Nothing is emitted until the input closes; if the context is cancelled first, nothing is emitted.
+//
+// Examples:
+//
+//	TakeLast(ctx, ch, 3)                        // last 3 values
+//	TakeLast(ctx, ch, 10, WithBuffer[int](10))  // with buffered output
+func TakeLast[T any](ctx context.Context, in <-chan T, count int, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		if count <= 0 {
+			go drain(in)
+			return
+		}
+
+		ring := make([]T, 0, count)
+		head := 0
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				if ctx.Err() != nil {
+					return
+				}
+				break
+			}
+
+			if len(ring) < count {
+				ring = append(ring, val)
+				continue
+			}
+			ring[head] = val
+			head = (head + 1) % count
+		}
+
+		for i := range ring {
+			if !send(ctx, outChan, ring[(head+i)%len(ring)]) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// SampleN emits the first value of every group of 'n' values and discards the rest.
+// Values at zero-based positions 0, n, 2n, ... are emitted. This is a deterministic,
+// count-based alternative to time-based sampling. An n of 1 or less passes everything through.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	SampleN(ctx, ch, 3)                         // [1, 2, 3, 4, 5, 6, 7] -> [1, 4, 7]
+//	SampleN(ctx, ch, 10, WithBuffer[int](5))    // with buffered output
+func SampleN[T any](ctx context.Context, in <-chan T, n int, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		index := 0
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			keep := n <= 1 || index%n == 0
+			index++
+
+			if keep && !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// TakeIndexed emits only the values for which the predicate returns true. The predicate
+// receives the zero-based position of each value alongside the value itself, which makes
+// position-aware selection possible without external counters.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	TakeIndexed(ctx, ch, func(i int, _ string) bool { return i%2 == 0 })  // [a, b, c, d] -> [a, c]
+//	TakeIndexed(ctx, ch, func(i int, _ int) bool { return i < 5 })        // like Take(ctx, ch, 5), but drains
+func TakeIndexed[T any](ctx context.Context, in <-chan T, pred func(i int, v T) bool, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		index := 0
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			keep := pred(index, val)
+			index++
+
+			if keep && !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// SkipIndexed discards the values for which the predicate returns true and emits the rest.
+// It is the complement of TakeIndexed; the predicate receives the zero-based position of each value.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	SkipIndexed(ctx, ch, func(i int, _ string) bool { return i%2 == 0 })  // [a, b, c, d] -> [b, d]
+//	SkipIndexed(ctx, ch, func(i int, _ int) bool { return i == 0 })       // drop a header row
+func SkipIndexed[T any](ctx context.Context, in <-chan T, pred func(i int, v T) bool, opts ...ChanOption[T]) <-chan T {
+	return TakeIndexed(ctx, in, func(i int, v T) bool { return !pred(i, v) }, opts...)
+}
+
+// Latch forwards only the first value from the input channel and then closes.
+// Unlike Take(ctx, in, 1), the rest of the input is drained in the background,
+// so the producer is released rather than left blocked.
+// The output channel closes after the first value, when the input closes, or when context is cancelled.
+//
+// Examples:
+//
+//	Latch(ctx, readySignals)                 // wait for the first readiness event
+//	Latch(ctx, results, WithBuffer[int](1))  // let the send complete without a waiting reader
+func Latch[T any](ctx context.Context, in <-chan T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return
+		}
+
+		send(ctx, outChan, val)
+		go drain(in)
+	}()
+
+	return outChan
+}
+
+// SampleReservoir returns k values chosen uniformly at random from the whole stream,
+// using reservoir sampling (Algorithm R): the first k values fill the reservoir, and the
+// i-th value after that replaces a random slot with probability k/i. Memory is bounded by k
+// regardless of stream length. Pass a seeded rng for reproducible samples; nil uses a
+// randomly seeded generator. If the stream has fewer than k values, all of them are returned.
+// This is a blocking operation; if the context is cancelled, the current reservoir is returned.
+//
+// Examples:
+//
+//	SampleReservoir(ctx, logs, 100, nil)                             // 100 random log lines
+//	SampleReservoir(ctx, ch, 5, rand.New(rand.NewPCG(1, 2)))         // reproducible sample
+func SampleReservoir[T any](ctx context.Context, in <-chan T, k int, rng *rand.Rand) []T {
+	if k <= 0 {
+		go drain(in)
+		return nil
+	}
+	if rng == nil {
+		rng = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+
+	reservoir := make([]T, 0, k)
+	for seen := 0; ; seen++ {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return reservoir
+		}
+
+		if seen < k {
+			reservoir = append(reservoir, val)
+			continue
+		}
+
+		if j := rng.IntN(seen + 1); j < k {
+			reservoir[j] = val
+		}
+	}
+}
+
+// Slice skips the first 'offset' values and then emits up to 'limit' values, like SQL's
+// OFFSET and LIMIT, in a single stage. Once the limit is reached the rest of the input is
+// drained in the background so the producer is released. A negative offset is treated as zero.
+// The output channel closes when the limit is reached, the input closes, or context is cancelled.
+//
+// Examples:
+//
+//	Slice(ctx, ch, 10, 5)                       // values 10-14 of the stream
+//	Slice(ctx, ch, 0, 3)                        // same as Take(ctx, ch, 3), but drains
+func Slice[T any](ctx context.Context, in <-chan T, offset, limit int, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		for range max(offset, 0) {
+			if _, ok := recieve(ctx, in); !ok {
+				return
+			}
+		}
+
+		for range max(limit, 0) {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+			if !send(ctx, outChan, val) {
+				return
+			}
+		}
+
+		go drain(in)
+	}()
+
+	return outChan
+}
+
+// IndexOf returns the zero-based position of the first value equal to target, or -1 if the
+// stream ends or the context is cancelled before a match. After a match the rest of the
+// input is drained in the background so the producer is released.
+// This is a blocking operation.
+//
+// Examples:
+//
+//	IndexOf(ctx, ch, 42)                        // [7, 42, 3] -> 1
+//	IndexOf(ctx, lines, "")                     // position of the first blank line
+func IndexOf[T comparable](ctx context.Context, in <-chan T, target T) int {
+	return IndexWhere(ctx, in, func(v T) bool { return v == target })
+}
+
+// IndexWhere returns the zero-based position of the first value satisfying the predicate,
+// or -1 if the stream ends or the context is cancelled before a match. After a match the
+// rest of the input is drained in the background so the producer is released.
+// This is a blocking operation.
+//
+// Examples:
+//
+//	IndexWhere(ctx, ch, func(x int) bool { return x < 0 })        // first negative value
+//	IndexWhere(ctx, users, func(u User) bool { return u.Admin })   // first admin
+func IndexWhere[T any](ctx context.Context, in <-chan T, pred func(T) bool) int {
+	for index := 0; ; index++ {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return -1
+		}
+
+		if pred(val) {
+			go drain(in)
+			return index
+		}
+	}
+}
@@ -2,6 +2,8 @@ package chankit
 
 import (
 	"context"
+	"math/rand/v2"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -947,3 +949,477 @@ func TestSkipWhile(t *testing.T) {
 		}
 	})
 }
+
+// TestTakeLast tests the TakeLast function
+func TestTakeLast(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		count    int
+		expected []int
+	}{
+		{"last three", []int{1, 2, 3, 4, 5}, 3, []int{3, 4, 5}},
+		{"count exceeds input", []int{1, 2}, 5, []int{1, 2}},
+		{"exact length", []int{1, 2, 3}, 3, []int{1, 2, 3}},
+		{"zero count", []int{1, 2, 3}, 0, nil},
+		{"empty input", []int{}, 2, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			in := SliceToChan(ctx, tt.input)
+
+			var results []int
+			for val := range TakeLast(ctx, in, tt.count) {
+				results = append(results, val)
+			}
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, results)
+			}
+			for i, v := range results {
+				if v != tt.expected[i] {
+					t.Errorf("at index %d: expected %d, got %d", i, tt.expected[i], v)
+				}
+			}
+		})
+	}
+
+	t.Run("context cancellation emits nothing", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int, 3)
+		in <- 1
+		in <- 2
+		in <- 3
+
+		out := TakeLast(ctx, in, 2)
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		select {
+		case val, ok := <-out:
+			if ok {
+				t.Errorf("expected no values after cancellation, got %d", val)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSampleN tests the SampleN function
+func TestSampleN(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		n        int
+		expected []int
+	}{
+		{"every third", []int{1, 2, 3, 4, 5, 6, 7}, 3, []int{1, 4, 7}},
+		{"every second", []int{1, 2, 3, 4}, 2, []int{1, 3}},
+		{"n of one passes all", []int{1, 2, 3}, 1, []int{1, 2, 3}},
+		{"n larger than input", []int{1, 2, 3}, 10, []int{1}},
+		{"empty input", []int{}, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			in := SliceToChan(ctx, tt.input)
+
+			var results []int
+			for val := range SampleN(ctx, in, tt.n) {
+				results = append(results, val)
+			}
+
+			if len(results) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, results)
+			}
+			for i, v := range results {
+				if v != tt.expected[i] {
+					t.Errorf("at index %d: expected %d, got %d", i, tt.expected[i], v)
+				}
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := SampleN(ctx, in, 2)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestTakeIndexed tests the TakeIndexed function
+func TestTakeIndexed(t *testing.T) {
+	t.Run("takes even-indexed values", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"a", "b", "c", "d"})
+
+		var results []string
+		for val := range TakeIndexed(ctx, in, func(i int, _ string) bool { return i%2 == 0 }) {
+			results = append(results, val)
+		}
+
+		expected := []string{"a", "c"}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("predicate sees index and value", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{0, 5, 2, 7, 4})
+
+		var results []int
+		for val := range TakeIndexed(ctx, in, func(i int, v int) bool { return i == v }) {
+			results = append(results, val)
+		}
+
+		expected := []int{0, 2, 4}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := TakeIndexed(ctx, in, func(int, int) bool { return true })
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSkipIndexed tests the SkipIndexed function
+func TestSkipIndexed(t *testing.T) {
+	t.Run("skips even-indexed values", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"a", "b", "c", "d"})
+
+		var results []string
+		for val := range SkipIndexed(ctx, in, func(i int, _ string) bool { return i%2 == 0 }) {
+			results = append(results, val)
+		}
+
+		expected := []string{"b", "d"}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expected[i], v)
+			}
+		}
+	})
+}
+
+// TestLatch tests the Latch function
+func TestLatch(t *testing.T) {
+	t.Run("emits only the first value and drains the producer", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		producerDone := make(chan struct{})
+
+		go func() {
+			defer close(producerDone)
+			defer close(in)
+			for i := 1; i <= 10; i++ {
+				in <- i
+			}
+		}()
+
+		var results []int
+		for val := range Latch(ctx, in) {
+			results = append(results, val)
+		}
+
+		if len(results) != 1 || results[0] != 1 {
+			t.Errorf("expected [1], got %v", results)
+		}
+
+		select {
+		case <-producerDone:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("producer was not drained")
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		close(in)
+
+		if _, ok := <-Latch(ctx, in); ok {
+			t.Error("expected channel to be closed")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Latch(ctx, make(chan int))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSampleReservoir tests the SampleReservoir function
+func TestSampleReservoir(t *testing.T) {
+	sample := func(seed uint64, k int, n int) []int {
+		ctx := context.Background()
+		return SampleReservoir(ctx, Range(ctx, 0, n, 1), k, rand.New(rand.NewPCG(seed, seed)))
+	}
+
+	t.Run("fixed seed is reproducible", func(t *testing.T) {
+		first := sample(42, 5, 1000)
+		second := sample(42, 5, 1000)
+
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("expected identical samples, got %v and %v", first, second)
+		}
+		if len(first) != 5 {
+			t.Fatalf("expected 5 values, got %d", len(first))
+		}
+
+		seen := make(map[int]bool)
+		for _, v := range first {
+			if v < 0 || v >= 1000 || seen[v] {
+				t.Errorf("expected distinct values from the input, got %v", first)
+			}
+			seen[v] = true
+		}
+	})
+
+	t.Run("short stream returns everything", func(t *testing.T) {
+		result := sample(1, 10, 3)
+		if !reflect.DeepEqual(result, []int{0, 1, 2}) {
+			t.Errorf("expected [0 1 2], got %v", result)
+		}
+	})
+
+	t.Run("roughly uniform selection", func(t *testing.T) {
+		counts := make([]int, 10)
+		for seed := uint64(0); seed < 2000; seed++ {
+			for _, v := range sample(seed, 1, 10) {
+				counts[v]++
+			}
+		}
+		for v, c := range counts {
+			if c < 120 || c > 280 {
+				t.Errorf("value %d selected %d times out of 2000, expected about 200", v, c)
+			}
+		}
+	})
+
+	t.Run("context cancellation returns current reservoir", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+
+		go func() {
+			in <- 1
+			in <- 2
+			cancel()
+		}()
+
+		result := SampleReservoir(ctx, in, 5, rand.New(rand.NewPCG(1, 1)))
+		if !reflect.DeepEqual(result, []int{1, 2}) {
+			t.Errorf("expected [1 2], got %v", result)
+		}
+	})
+}
+
+// TestSlice tests the Slice function
+func TestSlice(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	tests := []struct {
+		name     string
+		offset   int
+		limit    int
+		expected []int
+	}{
+		{"middle of stream", 3, 4, []int{4, 5, 6, 7}},
+		{"from the start", 0, 3, []int{1, 2, 3}},
+		{"limit past the end", 8, 5, []int{9, 10}},
+		{"offset past the end", 15, 5, nil},
+		{"zero limit", 2, 0, nil},
+		{"negative offset", -2, 2, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var result []int
+			for v := range Slice(ctx, SliceToChan(ctx, input), tt.offset, tt.limit) {
+				result = append(result, v)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("drains the producer after the limit", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		producerDone := make(chan struct{})
+
+		go func() {
+			defer close(producerDone)
+			defer close(in)
+			for i := 0; i < 100; i++ {
+				in <- i
+			}
+		}()
+
+		for range Slice(ctx, in, 1, 2) {
+		}
+
+		select {
+		case <-producerDone:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("producer was not drained")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Slice(ctx, make(chan int), 1, 1)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestIndexOf tests the IndexOf function
+func TestIndexOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		target   int
+		expected int
+	}{
+		{"hit", []int{7, 42, 3, 42}, 42, 1},
+		{"hit at start", []int{5, 6}, 5, 0},
+		{"miss", []int{1, 2, 3}, 9, -1},
+		{"empty stream", []int{}, 1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if got := IndexOf(ctx, SliceToChan(ctx, tt.input), tt.target); got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+
+	t.Run("drains the producer after a hit", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		producerDone := make(chan struct{})
+
+		go func() {
+			defer close(producerDone)
+			defer close(in)
+			for i := 0; i < 100; i++ {
+				in <- i
+			}
+		}()
+
+		if got := IndexOf(ctx, in, 3); got != 3 {
+			t.Errorf("expected 3, got %d", got)
+		}
+
+		select {
+		case <-producerDone:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("producer was not drained")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if got := IndexOf(ctx, make(chan int), 1); got != -1 {
+			t.Errorf("expected -1, got %d", got)
+		}
+	})
+}
+
+// TestIndexWhere tests the IndexWhere function
+func TestIndexWhere(t *testing.T) {
+	isNegative := func(x int) bool { return x < 0 }
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected int
+	}{
+		{"hit", []int{3, 1, -4, -1}, 2},
+		{"miss", []int{3, 1, 4}, -1},
+		{"empty stream", []int{}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			if got := IndexWhere(ctx, SliceToChan(ctx, tt.input), isNegative); got != tt.expected {
+				t.Errorf("expected %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
@@ -79,3 +79,90 @@ func ChanToSlice[T any](ctx context.Context, ch <-chan T, opts ...SliceOption[T]
 		}
 	}
 }
+
+// ChanToSet collects the unique values of a channel into a slice, preserving first-seen order.
+// It replaces a separate Distinct stage in front of ChanToSlice. WithCapacity() pre-allocates
+// both the result and the internal seen-set. If the context is cancelled, the values collected so far are returned.
+//
+// Examples:
+//
+//	ChanToSet(ctx, ch)                          // [1, 2, 1, 3, 2] -> [1, 2, 3]
+//	ChanToSet(ctx, ch, WithCapacity[int](100))  // pre-allocated capacity
+func ChanToSet[T comparable](ctx context.Context, ch <-chan T, opts ...SliceOption[T]) []T {
+	cfg := &sliceConfig[T]{initialCapacity: 0}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	slice := make([]T, 0, cfg.initialCapacity)
+	seen := make(map[T]struct{}, cfg.initialCapacity)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return slice
+		case item, ok := <-ch:
+			if !ok {
+				return slice
+			}
+			if _, dup := seen[item]; dup {
+				continue
+			}
+			seen[item] = struct{}{}
+			slice = append(slice, item)
+		}
+	}
+}
+
+// MapOption is a functional option for configuring map collection behavior
+type MapOption[V any] func(*mapConfig[V])
+
+// mapConfig holds configuration for map collection
+type mapConfig[V any] struct {
+	merge func(existing, incoming V) V
+}
+
+// WithMapMerge sets a function to resolve key collisions when collecting into a map.
+// It receives the value already stored for the key and the incoming value, and returns
+// the value to keep. Without it, the last value written for a key wins.
+func WithMapMerge[V any](merge func(existing, incoming V) V) MapOption[V] {
+	return func(cfg *mapConfig[V]) {
+		cfg.merge = merge
+	}
+}
+
+// ChanToMap drains a channel into a map, deriving each entry's key with keyFn and value with valFn.
+// By default, later values overwrite earlier ones with the same key. Use WithMapMerge() to combine them.
+// If the context is cancelled, the partially collected map is returned.
+//
+// Examples:
+//
+//	ChanToMap(ctx, users, func(u User) int { return u.ID }, func(u User) string { return u.Name })
+//	ChanToMap(ctx, words, identity, one, WithMapMerge(func(a, b int) int { return a + b }))  // count words
+func ChanToMap[T any, K comparable, V any](ctx context.Context, ch <-chan T, keyFn func(T) K, valFn func(T) V, opts ...MapOption[V]) map[K]V {
+	cfg := &mapConfig[V]{}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	result := make(map[K]V)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return result
+		case item, ok := <-ch:
+			if !ok {
+				return result
+			}
+
+			key, val := keyFn(item), valFn(item)
+			if existing, found := result[key]; found && cfg.merge != nil {
+				val = cfg.merge(existing, val)
+			}
+			result[key] = val
+		}
+	}
+}
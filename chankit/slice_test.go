@@ -2,6 +2,7 @@ package chankit
 
 import (
 	"context"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -74,6 +75,7 @@ func TestSliceToChan_WithBufferAuto(t *testing.T) {
 
 func TestSliceToChan_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	input := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 
 	ch := SliceToChan(ctx, input)
@@ -273,6 +275,93 @@ func TestSliceToChan_StructType(t *testing.T) {
 	}
 }
 
+func TestChanToSet_Basic(t *testing.T) {
+	ctx := context.Background()
+	ch := SliceToChan(ctx, []int{1, 2, 1, 3, 2})
+
+	result := ChanToSet(ctx, ch)
+
+	expected := []int{1, 2, 3}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestChanToSet_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 100; i++ {
+			ch <- i % 3
+			time.Sleep(10 * time.Millisecond) // Slow producer
+		}
+	}()
+
+	result := ChanToSet(ctx, ch)
+
+	// Should have been interrupted by context timeout, keeping first-seen order
+	if len(result) == 0 || len(result) > 3 || result[0] != 1 {
+		t.Errorf("expected a partial set starting with 1, got %v", result)
+	}
+}
+
+func TestChanToMap_LastWriteWins(t *testing.T) {
+	ctx := context.Background()
+	ch := SliceToChan(ctx, []string{"apple", "avocado", "banana"})
+
+	result := ChanToMap(ctx, ch,
+		func(s string) byte { return s[0] },
+		func(s string) string { return s },
+	)
+
+	expected := map[byte]string{'a': "avocado", 'b': "banana"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestChanToMap_WithMapMerge(t *testing.T) {
+	ctx := context.Background()
+	ch := SliceToChan(ctx, []string{"apple", "avocado", "banana"})
+
+	result := ChanToMap(ctx, ch,
+		func(s string) byte { return s[0] },
+		func(string) int { return 1 },
+		WithMapMerge(func(existing, incoming int) int { return existing + incoming }),
+	)
+
+	expected := map[byte]int{'a': 2, 'b': 1}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestChanToMap_ContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	ch := make(chan int)
+
+	go func() {
+		defer close(ch)
+		for i := 1; i <= 100; i++ {
+			ch <- i
+			time.Sleep(10 * time.Millisecond) // Slow producer
+		}
+	}()
+
+	result := ChanToMap(ctx, ch, func(x int) int { return x }, func(x int) int { return x * x })
+
+	// Should have been interrupted by context timeout
+	if len(result) == 0 || len(result) >= 100 {
+		t.Errorf("expected a partial map due to context, but got %d entries", len(result))
+	}
+}
+
 // Benchmark tests
 func BenchmarkSliceToChan_Unbuffered(b *testing.B) {
 	ctx := context.Background()
@@ -1,7 +1,9 @@
 package chankit
 
 import (
+	"container/heap"
 	"context"
+	"errors"
 	"sync"
 	"time"
 )
@@ -53,11 +55,38 @@ func Delay[T any](ctx context.Context, in <-chan T, delay time.Duration, opts ..
 }
 
 func Timeout[T any](ctx context.Context, in <-chan T, timeout time.Duration, opts ...ChanOption[T]) <-chan T {
+	return timeoutIdle(ctx, in, timeout, nil, opts...)
+}
+
+// ErrTimeout is reported by TimeoutWithErr when no value arrives within the timeout.
+var ErrTimeout = errors.New("chankit: timed out waiting for a value")
+
+// TimeoutWithErr behaves like Timeout, but reports why the stream ended. If no value arrives
+// within d of the previous one, ErrTimeout is sent on the error channel before the value
+// channel closes. If the input closes or the context is cancelled, the error channel closes
+// without a value. The error channel is buffered, so it need not be read.
+//
+// Example:
+//
+//	out, errs := TimeoutWithErr(ctx, in, time.Second)
+//	for v := range out { ... }
+//	if err := <-errs; errors.Is(err, ErrTimeout) { ... }  // nil on a normal end
+func TimeoutWithErr[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) (<-chan T, <-chan error) {
+	errChan := make(chan error, 1)
+	return timeoutIdle(ctx, in, d, errChan, opts...), errChan
+}
+
+// timeoutIdle implements Timeout and TimeoutWithErr. If errChan is non-nil, it receives
+// ErrTimeout when the idle timer fires and is closed when the operator stops.
+func timeoutIdle[T any](ctx context.Context, in <-chan T, d time.Duration, errChan chan<- error, opts ...ChanOption[T]) <-chan T {
 	outChan := applyChanOptions(opts...)
 
 	go func() {
+		if errChan != nil {
+			defer close(errChan)
+		}
 		defer close(outChan)
-		timer := time.NewTimer(timeout)
+		timer := time.NewTimer(d)
 		defer timer.Stop()
 
 		for {
@@ -66,22 +95,228 @@ func Timeout[T any](ctx context.Context, in <-chan T, timeout time.Duration, opt
 				return
 
 			case <-timer.C:
+				if errChan != nil {
+					errChan <- ErrTimeout
+				}
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					return
+				}
+
+				timer.Reset(d)
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DelayUntil holds each value until the wall-clock time returned by timeFn and then emits it;
+// values whose time has already passed are emitted immediately. Pending values are kept in a
+// priority queue, so the output is ordered by timestamp, with ties in arrival order.
+// Values still pending when the input closes are emitted at their times before the output closes.
+//
+// Example:
+//
+//	Input:  [{a, now+200ms}, {b, now+50ms}, {c, now-1s}] at 0ms
+//	Output: [c] (0ms), [b] (50ms), [a] (200ms)
+func DelayUntil[T any](ctx context.Context, in <-chan T, timeFn func(T) time.Time, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		var queue delayQueue[T]
+		timer := time.NewTimer(0)
+		defer timer.Stop()
+		seq := 0
+
+		for in != nil || queue.Len() > 0 {
+			var timerCh <-chan time.Time
+			if queue.Len() > 0 {
+				timer.Reset(time.Until(queue[0].at))
+				timerCh = timer.C
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				heap.Push(&queue, delayedValue[T]{at: timeFn(val), seq: seq, val: val})
+				seq++
+
+			case <-timerCh:
+				for queue.Len() > 0 && !time.Now().Before(queue[0].at) {
+					due := heap.Pop(&queue).(delayedValue[T])
+					if !send(ctx, outChan, due.val) {
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// delayedValue is a value waiting in DelayUntil's queue; seq breaks timestamp ties by arrival.
+type delayedValue[T any] struct {
+	at  time.Time
+	seq int
+	val T
+}
+
+// delayQueue is a min-heap of delayed values ordered by time, then arrival.
+type delayQueue[T any] []delayedValue[T]
+
+func (q delayQueue[T]) Len() int { return len(q) }
+
+func (q delayQueue[T]) Less(i, j int) bool {
+	if q[i].at.Equal(q[j].at) {
+		return q[i].seq < q[j].seq
+	}
+	return q[i].at.Before(q[j].at)
+}
+
+func (q delayQueue[T]) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *delayQueue[T]) Push(x any) { *q = append(*q, x.(delayedValue[T])) }
+
+func (q *delayQueue[T]) Pop() any {
+	old := *q
+	last := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return last
+}
+
+// TimeoutFirst closes the output if the first value does not arrive within d of the call.
+// Once the first value has arrived, the rest of the stream is forwarded with no further
+// per-value timeout. Unlike Timeout, a slow stream is only penalized before it starts.
+//
+// Example:
+//
+//	out := TimeoutFirst(ctx, in, 2*time.Second) // fail fast on a cold source, then stream freely
+func TimeoutFirst[T any](ctx context.Context, in <-chan T, d time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+	timer := time.NewTimer(d)
+
+	go func() {
+		defer close(outChan)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+
+		case <-timer.C:
+			go drain(in)
+			return
+
+		case val, ok := <-in:
+			timer.Stop()
+			if !ok || !send(ctx, outChan, val) {
 				return
+			}
+		}
+
+		forwardSimple(ctx, outChan, in)
+	}()
+
+	return outChan
+}
+
+// OnIdle passes all values through unchanged and calls fn whenever no value has arrived
+// for the given duration. The idle timer starts immediately and is reset by every value;
+// fn fires at most once per idle period and is re-armed by the next value. Unlike Timeout,
+// the stream is not terminated when it stalls.
+//
+// Example:
+//
+//	out := OnIdle(ctx, in, 5*time.Second, func() { log.Println("stream stalled") })
+func OnIdle[T any](ctx context.Context, in <-chan T, d time.Duration, fn func(), opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-timer.C:
+				fn()
 
 			case val, ok := <-in:
 				if !ok {
 					return
 				}
 
-				timer.Reset(timeout)
-				select {
-				case <-ctx.Done():
+				if !send(ctx, outChan, val) {
 					return
-				case outChan <- val:
 				}
+				timer.Reset(d)
 			}
 		}
+	}()
+
+	return outChan
+}
+
+// SampleHold emits the most recently received value once every interval, repeating it
+// while no new value arrives. This fills gaps in a sparse stream with the held value.
+// The cadence starts when the first value arrives; nothing is emitted before that.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Example:
+//
+//	Input:    [20.5] at 0ms, [21.0] at 250ms, then silence
+//	Interval: 100ms
+//	Output:   [20.5] (100ms), [20.5] (200ms), [21.0] (300ms), [21.0] (400ms), ...
+func SampleHold[T any](ctx context.Context, in <-chan T, interval time.Duration, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		held, ok := recieve(ctx, in)
+		if !ok {
+			return
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					return
+				}
+				held = val
 
+			case <-ticker.C:
+				if !send(ctx, outChan, held) {
+					return
+				}
+			}
+		}
 	}()
+
 	return outChan
 }
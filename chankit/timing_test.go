@@ -2,6 +2,8 @@ package chankit
 
 import (
 	"context"
+	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -855,3 +857,368 @@ func TestTimeout(t *testing.T) {
 		}
 	})
 }
+
+// TestOnIdle tests the OnIdle function
+func TestOnIdle(t *testing.T) {
+	t.Run("fires during a gap and keeps streaming", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		var idleCount atomic.Int32
+
+		out := OnIdle(ctx, in, 30*time.Millisecond, func() { idleCount.Add(1) })
+
+		go func() {
+			in <- 1
+			in <- 2
+			time.Sleep(80 * time.Millisecond) // gap longer than the idle duration
+			in <- 3
+			close(in)
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %d values, got %d", len(expected), len(results))
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+		if n := idleCount.Load(); n != 1 {
+			t.Errorf("expected idle callback once, got %d", n)
+		}
+	})
+
+	t.Run("does not fire for a busy stream", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		var idleCount atomic.Int32
+
+		out := OnIdle(ctx, in, 50*time.Millisecond, func() { idleCount.Add(1) })
+
+		go func() {
+			for i := 0; i < 10; i++ {
+				in <- i
+				time.Sleep(10 * time.Millisecond)
+			}
+			close(in)
+		}()
+
+		for range out {
+		}
+		if n := idleCount.Load(); n != 0 {
+			t.Errorf("expected no idle callbacks, got %d", n)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := OnIdle(ctx, in, 10*time.Millisecond, func() {})
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSampleHold tests the SampleHold function
+func TestSampleHold(t *testing.T) {
+	t.Run("repeats the last value during silence", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := SampleHold(ctx, in, 30*time.Millisecond)
+
+		go func() {
+			in <- 1
+			time.Sleep(100 * time.Millisecond)
+			in <- 2
+			time.Sleep(100 * time.Millisecond)
+			close(in)
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		ones, twos := 0, 0
+		for _, v := range results {
+			switch v {
+			case 1:
+				ones++
+			case 2:
+				twos++
+			}
+		}
+		if ones < 2 || twos < 2 {
+			t.Fatalf("expected each value to be held for several ticks, got %v", results)
+		}
+		for i := 1; i < len(results); i++ {
+			if results[i] < results[i-1] {
+				t.Errorf("expected held values in arrival order, got %v", results)
+				break
+			}
+		}
+	})
+
+	t.Run("emits nothing before the first value", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out := SampleHold(ctx, in, 10*time.Millisecond)
+
+		select {
+		case v := <-out:
+			t.Errorf("expected no emission before first value, got %d", v)
+		case <-time.After(50 * time.Millisecond):
+		}
+		close(in)
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := SampleHold(ctx, in, 10*time.Millisecond)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestTimeoutFirst tests the TimeoutFirst function
+func TestTimeoutWithErr(t *testing.T) {
+	t.Run("reports a timeout", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		out, errs := TimeoutWithErr(ctx, in, 50*time.Millisecond)
+
+		go func() {
+			in <- 1
+			in <- 2
+			// Stall without closing, so the timeout fires.
+		}()
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		if len(results) != 2 {
+			t.Errorf("expected 2 values before the timeout, got %v", results)
+		}
+		if err := <-errs; !errors.Is(err, ErrTimeout) {
+			t.Errorf("expected ErrTimeout, got %v", err)
+		}
+		if _, ok := <-errs; ok {
+			t.Error("expected error channel to be closed after the timeout error")
+		}
+	})
+
+	t.Run("normal close reports no error", func(t *testing.T) {
+		ctx := context.Background()
+		out, errs := TimeoutWithErr(ctx, SliceToChan(ctx, []int{1, 2, 3}), time.Second)
+
+		var results []int
+		for val := range out {
+			results = append(results, val)
+		}
+
+		if len(results) != 3 {
+			t.Errorf("expected 3 values, got %v", results)
+		}
+		if err, ok := <-errs; ok {
+			t.Errorf("expected error channel to close without a value, got %v", err)
+		}
+	})
+
+	t.Run("context cancellation reports no error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out, errs := TimeoutWithErr(ctx, make(chan int), time.Second)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+		if err, ok := <-errs; ok {
+			t.Errorf("expected error channel to close without a value, got %v", err)
+		}
+	})
+}
+
+func TestDelayUntil(t *testing.T) {
+	type event struct {
+		name string
+		at   time.Time
+	}
+	eventTime := func(e event) time.Time { return e.at }
+
+	t.Run("emits values at their timestamps", func(t *testing.T) {
+		ctx := context.Background()
+		start := time.Now()
+		input := []event{
+			{"past", start.Add(-time.Second)},
+			{"a", start.Add(50 * time.Millisecond)},
+			{"b", start.Add(100 * time.Millisecond)},
+			{"c", start.Add(150 * time.Millisecond)},
+		}
+
+		var names []string
+		var offsets []time.Duration
+		for e := range DelayUntil(ctx, SliceToChan(ctx, input), eventTime) {
+			names = append(names, e.name)
+			offsets = append(offsets, time.Since(start))
+		}
+
+		expectedNames := []string{"past", "a", "b", "c"}
+		if len(names) != len(expectedNames) {
+			t.Fatalf("expected %v, got %v", expectedNames, names)
+		}
+
+		expectedOffsets := []time.Duration{0, 50 * time.Millisecond, 100 * time.Millisecond, 150 * time.Millisecond}
+		for i, name := range names {
+			if name != expectedNames[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expectedNames[i], name)
+			}
+			if offsets[i] < expectedOffsets[i] || offsets[i] > expectedOffsets[i]+40*time.Millisecond {
+				t.Errorf("%s: expected emission at ~%v, got %v", name, expectedOffsets[i], offsets[i])
+			}
+		}
+	})
+
+	t.Run("orders by timestamp rather than arrival", func(t *testing.T) {
+		ctx := context.Background()
+		start := time.Now()
+		input := []event{
+			{"late", start.Add(60 * time.Millisecond)},
+			{"early", start.Add(20 * time.Millisecond)},
+			{"tie", start.Add(60 * time.Millisecond)},
+		}
+
+		var names []string
+		for e := range DelayUntil(ctx, SliceToChan(ctx, input), eventTime) {
+			names = append(names, e.name)
+		}
+
+		expected := []string{"early", "late", "tie"}
+		if len(names) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, names)
+		}
+		for i, name := range names {
+			if name != expected[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expected[i], name)
+			}
+		}
+	})
+
+	t.Run("context cancellation while waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := SliceToChan(ctx, []event{{"far", time.Now().Add(time.Hour)}})
+		out := DelayUntil(ctx, in, eventTime)
+
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed without a value")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestTimeoutFirst(t *testing.T) {
+	t.Run("closes when the first value is late", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			in <- 1
+			close(in)
+		}()
+
+		var results []int
+		for val := range TimeoutFirst(ctx, in, 30*time.Millisecond) {
+			results = append(results, val)
+		}
+
+		if len(results) != 0 {
+			t.Errorf("expected no values, got %v", results)
+		}
+	})
+
+	t.Run("streams freely once the first value arrives", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+
+		go func() {
+			defer close(in)
+			in <- 1
+			// Later gaps exceed d but must not terminate the stream.
+			for i := 2; i <= 3; i++ {
+				time.Sleep(50 * time.Millisecond)
+				in <- i
+			}
+		}()
+
+		var results []int
+		for val := range TimeoutFirst(ctx, in, 30*time.Millisecond) {
+			results = append(results, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := TimeoutFirst(ctx, make(chan int), time.Hour)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
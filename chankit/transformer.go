@@ -1,6 +1,13 @@
 package chankit
 
-import "context"
+import (
+	"cmp"
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
 
 // Map applies a transformation function to each value from the input channel.
 // The output channel closes when the input closes or context is cancelled.
@@ -56,6 +63,130 @@ func Filter[T any](ctx context.Context, in <-chan T, filterFunc func(T) bool, op
 	return outChan
 }
 
+// FilterErr creates a channel that only emits values satisfying a fallible predicate.
+// The stream stops at the first predicate error; the error can be retrieved through the
+// returned function, which reports nil if the stream ended cleanly. The function should
+// be called once the output channel has been drained.
+// The output channel closes when the input closes, the predicate fails, or context is cancelled.
+//
+// Examples:
+//
+//	out, errFn := FilterErr(ctx, ch, func(x int) (bool, error) { ... })
+//	for v := range out { ... }
+//	if err := errFn(); err != nil { ... }
+func FilterErr[T any](ctx context.Context, in <-chan T, pred func(T) (bool, error), opts ...ChanOption[T]) (<-chan T, func() error) {
+	outChan := applyChanOptions(opts...)
+	var errs errHolder
+
+	go func() {
+		defer close(outChan)
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			keep, err := pred(val)
+			if err != nil {
+				errs.set(err)
+				go drain(in)
+				return
+			}
+
+			if keep && !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan, errs.get
+}
+
+// FilterParallel emits the values satisfying the predicate like Filter, but evaluates the
+// predicate across 'workers' goroutines. Input order is preserved: results are held in a
+// reorder buffer until every earlier value has been decided. At most 2*workers values are in
+// flight at once, which bounds the buffer. Use it when the predicate is expensive (regex,
+// remote checks); for cheap predicates plain Filter is faster.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	FilterParallel(ctx, urls, 8, isReachable)                // 8 concurrent checks, input order kept
+//	FilterParallel(ctx, lines, 4, re.MatchString)            // parallel regex filtering
+func FilterParallel[T any](ctx context.Context, in <-chan T, workers int, pred func(T) bool, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+	workers = max(workers, 1)
+
+	type job struct {
+		seq int
+		val T
+	}
+	type verdict struct {
+		job
+		keep bool
+	}
+
+	go func() {
+		defer close(outChan)
+
+		jobs := make(chan job)
+		verdicts := make(chan verdict)
+		slots := make(chan struct{}, 2*workers)
+
+		go func() {
+			defer close(jobs)
+			for seq := 0; ; seq++ {
+				if !send(ctx, slots, struct{}{}) {
+					return
+				}
+				val, ok := recieve(ctx, in)
+				if !ok || !send(ctx, jobs, job{seq, val}) {
+					return
+				}
+			}
+		}()
+
+		var wg sync.WaitGroup
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := range jobs {
+					if !send(ctx, verdicts, verdict{j, pred(j.val)}) {
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			close(verdicts)
+		}()
+
+		pending := make(map[int]verdict, 2*workers)
+		next := 0
+		for v := range verdicts {
+			pending[v.seq] = v
+			for {
+				ready, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next++
+				<-slots
+
+				if ready.keep && !send(ctx, outChan, ready.val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
 // Reduce aggregates all values from the input channel into a single result.
 // This is a blocking operation that returns when the channel closes or context is cancelled.
 //
@@ -74,3 +205,915 @@ func Reduce[T, R any](ctx context.Context, in <-chan T, reduceFunc func(R, T) R,
 		accumulator = reduceFunc(accumulator, val)
 	}
 }
+
+// ReduceCheckpoint aggregates all values like Reduce, additionally calling onCheckpoint with the
+// current accumulator after every 'every' values processed. This allows reporting progress
+// during long reductions. A value of every <= 0 disables checkpoints.
+// This is a blocking operation that returns when the channel closes or context is cancelled.
+//
+// Examples:
+//
+//	ReduceCheckpoint(ctx, ch, sum, 0, 1000, func(acc int) { log.Printf("partial sum: %d", acc) })
+func ReduceCheckpoint[T, R any](ctx context.Context, in <-chan T, reduceFunc func(R, T) R, initial R, every int, onCheckpoint func(R)) R {
+	accumulator := initial
+	processed := 0
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return accumulator
+		}
+
+		accumulator = reduceFunc(accumulator, val)
+		processed++
+		if every > 0 && processed%every == 0 {
+			onCheckpoint(accumulator)
+		}
+	}
+}
+
+// ReduceParallel aggregates all values using 'workers' goroutines that each reduce a share of
+// the stream into a partial result starting from identity; the partials are then combined with fn.
+// The caller asserts that fn is associative and commutative and that identity is its neutral
+// element, since values are distributed across workers in arrival order. This is a blocking
+// operation; if the context is cancelled, the combination of the partials gathered so far is returned.
+//
+// Examples:
+//
+//	ReduceParallel(ctx, ch, 4, func(a, b int) int { return a + b }, 0)       // parallel sum
+//	ReduceParallel(ctx, ch, 8, func(a, b *big.Int) *big.Int { ... }, one)   // parallel product
+func ReduceParallel[T any](ctx context.Context, in <-chan T, workers int, fn func(T, T) T, identity T) T {
+	workers = max(workers, 1)
+	partials := make([]T, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			partials[i] = Reduce(ctx, in, fn, identity)
+		}()
+	}
+	wg.Wait()
+
+	result := identity
+	for _, partial := range partials {
+		result = fn(result, partial)
+	}
+	return result
+}
+
+// ReduceWhile aggregates values like Reduce, but stops as soon as stop reports true for the
+// accumulator. It returns the accumulator and true if it stopped early; the remaining input
+// is drained in the background so the producer is not blocked. If the input closes or the
+// context is cancelled first, it returns the accumulator and false.
+//
+// Examples:
+//
+//	ReduceWhile(ctx, ch, sum, 0, func(acc int) bool { return acc > 10 })    // sum until over 10
+//	ReduceWhile(ctx, lines, appendLine, nil, func(b []string) bool { return len(b) >= 100 })
+func ReduceWhile[T, R any](ctx context.Context, in <-chan T, fn func(R, T) R, initial R, stop func(R) bool) (R, bool) {
+	accumulator := initial
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return accumulator, false
+		}
+
+		accumulator = fn(accumulator, val)
+		if stop(accumulator) {
+			go drain(in)
+			return accumulator, true
+		}
+	}
+}
+
+// ReduceByKey aggregates values sharing a key (as derived by keyFn) into a per-key accumulator.
+// Each key's accumulator starts from initial. This is a blocking operation that returns the
+// map of final accumulators when the channel closes, or the partial map if context is cancelled.
+//
+// Examples:
+//
+//	ReduceByKey(ctx, ch, parity, func(sum, x int) int { return sum + x }, 0)   // sum per parity
+//	ReduceByKey(ctx, orders, customerID, func(n int, o Order) int { return n + 1 }, 0)
+func ReduceByKey[T any, K comparable, R any](ctx context.Context, in <-chan T, keyFn func(T) K, reduceFunc func(R, T) R, initial R) map[K]R {
+	result := make(map[K]R)
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return result
+		}
+
+		key := keyFn(val)
+		acc, exists := result[key]
+		if !exists {
+			acc = initial
+		}
+		result[key] = reduceFunc(acc, val)
+	}
+}
+
+// Frequencies counts how many times each distinct value occurs in the stream.
+// This is a blocking operation that returns the histogram when the channel closes,
+// or the partial histogram if context is cancelled.
+//
+// Examples:
+//
+//	Frequencies(ctx, words)                     // {"the": 12, "a": 7, ...}
+//	Frequencies(ctx, Map(ctx, ch, statusCode))  // responses per status code
+func Frequencies[T comparable](ctx context.Context, in <-chan T) map[T]int {
+	counts := make(map[T]int)
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return counts
+		}
+		counts[val]++
+	}
+}
+
+// Accumulate applies reduceFunc to each value like Reduce, but returns every intermediate
+// accumulator state instead of only the final one. The initial value itself is not included.
+// This is a blocking operation that returns when the channel closes or context is cancelled,
+// in which case the states computed so far are returned.
+//
+// Examples:
+//
+//	Accumulate(ctx, ch, func(sum, x int) int { return sum + x }, 0)   // [1, 2, 3] -> [1, 3, 6]
+func Accumulate[T, R any](ctx context.Context, in <-chan T, reduceFunc func(R, T) R, initial R) []R {
+	var states []R
+	accumulator := initial
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return states
+		}
+		accumulator = reduceFunc(accumulator, val)
+		states = append(states, accumulator)
+	}
+}
+
+// MapAccum threads a state through the stream: for each value, fn receives the current
+// state and the value and returns the next state together with the value to emit. This
+// fuses a scan and a map into one stage for stateful transforms such as numbering or
+// running totals with custom formatting. The state starts at initial and is never emitted itself.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	MapAccum(ctx, ch, 0, func(sum, x int) (int, string) { return sum + x, fmt.Sprint(sum + x) })
+//	MapAccum(ctx, lines, 1, func(n int, s string) (int, string) { return n + 1, fmt.Sprintf("%d: %s", n, s) })
+func MapAccum[T, S, R any](ctx context.Context, in <-chan T, initial S, fn func(S, T) (S, R), opts ...ChanOption[R]) <-chan R {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		state := initial
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			var out R
+			state, out = fn(state, val)
+			if !send(ctx, outChan, out) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DistinctBy emits only the first value seen for each key derived by keyFn.
+// This allows deduplicating values of non-comparable types by a comparable attribute.
+// Every distinct key is remembered, so memory grows with the number of unique keys.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	DistinctBy(ctx, users, func(u User) int { return u.ID })           // dedupe by ID
+//	DistinctBy(ctx, ch, strings.ToLower)                               // case-insensitive
+func DistinctBy[T any, K comparable](ctx context.Context, in <-chan T, keyFn func(T) K, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		seen := make(map[K]struct{})
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			key := keyFn(val)
+			if _, exists := seen[key]; exists {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			if !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DistinctLastN suppresses values that appeared among the last n emitted values.
+// A ring buffer of size n tracks the recent window while a count map provides O(1)
+// membership checks. Once the window is full the oldest value is evicted, so a value
+// that recurs after n other values have been emitted is passed through again.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	DistinctLastN(ctx, ch, 3)                         // dedupe within the last 3 emissions
+//	DistinctLastN(ctx, ch, 100, WithBuffer[int](10))  // with buffering
+func DistinctLastN[T comparable](ctx context.Context, in <-chan T, n int, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		window := make([]T, 0, max(n, 0))
+		seen := make(map[T]int, max(n, 0))
+		head := 0
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if seen[val] > 0 {
+				continue
+			}
+
+			if n > 0 {
+				if len(window) < n {
+					window = append(window, val)
+				} else {
+					oldest := window[head]
+					if seen[oldest]--; seen[oldest] == 0 {
+						delete(seen, oldest)
+					}
+					window[head] = val
+					head = (head + 1) % n
+				}
+				seen[val]++
+			}
+
+			if !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// DistinctWindow suppresses values that were already emitted within the last ttl. It is
+// a time-based counterpart of DistinctLastN for deduplicating unbounded streams: each value
+// is remembered for ttl after it was emitted and forgotten afterwards, so memory is bounded
+// by the number of distinct values seen recently. It is ThrottleByKey keyed on the value itself.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	DistinctWindow(ctx, alerts, time.Minute)                // each alert at most once per minute
+//	DistinctWindow(ctx, ids, 5*time.Second, WithBuffer[int](10))
+func DistinctWindow[T comparable](ctx context.Context, in <-chan T, ttl time.Duration, opts ...ChanOption[T]) <-chan T {
+	return ThrottleByKey(ctx, in, func(v T) T { return v }, ttl, opts...)
+}
+
+// Route splits the input channel into one output channel per key in keys.
+// The selector is evaluated once per value and the value is sent to the channel of the
+// matching key. Values whose key is not present in keys are dropped, so no consumer is
+// required for them. All output channels close when the input closes or context is cancelled.
+//
+// Every returned channel must be consumed (or buffered via WithBuffer), since a value
+// waiting on one slow output blocks routing to all others.
+//
+// Examples:
+//
+//	outs := Route(ctx, ch, func(x int) string { ... }, []string{"neg", "zero", "pos"})
+//	outs := Route(ctx, ch, selector, keys, WithBuffer[int](10))   // buffered outputs
+func Route[T any, K comparable](ctx context.Context, in <-chan T, selector func(T) K, keys []K, opts ...ChanOption[T]) map[K]<-chan T {
+	outChans := make(map[K]chan T, len(keys))
+	result := make(map[K]<-chan T, len(keys))
+	for _, key := range keys {
+		if _, exists := outChans[key]; exists {
+			continue
+		}
+		ch := applyChanOptions(opts...)
+		outChans[key] = ch
+		result[key] = ch
+	}
+
+	go func() {
+		defer func() {
+			for _, ch := range outChans {
+				close(ch)
+			}
+		}()
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			out, found := outChans[selector(val)]
+			if !found {
+				continue
+			}
+
+			if !send(ctx, out, val) {
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+// GroupBy splits the input channel into a dynamic set of groups keyed by keyFn. The first
+// time a key is seen, a new group is emitted on the returned channel; that value and every
+// later value with the same key are sent to the group's Values channel, in order.
+// All group channels close when the input closes or context is cancelled.
+//
+// Groups must be consumed concurrently (or buffered via WithBuffer, which applies to each
+// group's channel), since a value waiting on one slow group blocks dispatch to all others,
+// including the announcement of new groups.
+//
+// Examples:
+//
+//	for g := range GroupBy(ctx, ch, func(x int) int { return x % 3 }) {
+//		go consume(g.Key, g.Values)
+//	}
+//	GroupBy(ctx, events, userID, WithBuffer[Event](100))   // buffered groups
+func GroupBy[T any, K comparable](ctx context.Context, in <-chan T, keyFn func(T) K, opts ...ChanOption[T]) <-chan struct {
+	Key    K
+	Values <-chan T
+} {
+	outChan := make(chan struct {
+		Key    K
+		Values <-chan T
+	})
+
+	go func() {
+		groups := make(map[K]chan T)
+		defer func() {
+			for _, ch := range groups {
+				close(ch)
+			}
+			close(outChan)
+		}()
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			key := keyFn(val)
+			group, exists := groups[key]
+			if !exists {
+				group = applyChanOptions(opts...)
+				groups[key] = group
+
+				announcement := struct {
+					Key    K
+					Values <-chan T
+				}{key, group}
+				if !send(ctx, outChan, announcement) {
+					return
+				}
+			}
+
+			if !send(ctx, group, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Intersperse emits sep between consecutive values from the input channel.
+// No separator is emitted before the first value or after the last one.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Intersperse(ctx, ch, 0)          // [1, 2, 3] -> [1, 0, 2, 0, 3]
+//	Intersperse(ctx, words, ", ")    // ["a", "b"] -> ["a", ", ", "b"]
+func Intersperse[T any](ctx context.Context, in <-chan T, sep T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		first := true
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if !first && !send(ctx, outChan, sep) {
+				return
+			}
+			first = false
+
+			if !send(ctx, outChan, val) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Result carries either a value or the error that occurred while producing it.
+// It allows failures to flow through a channel alongside successful values.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// SkipErrors forwards the values of successful results and drops any Result with a non-nil error.
+// Use WithErrorSink to observe the dropped errors; the sink is called synchronously in stream order.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	SkipErrors(ctx, results)                                              // ignore failures
+//	SkipErrors(ctx, results, WithErrorSink[int](func(err error) { ... }))  // log failures
+func SkipErrors[T any](ctx context.Context, in <-chan Result[T], opts ...ChanOption[T]) <-chan T {
+	cfg := &chanConfig[T]{bufferSize: 0}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for {
+			res, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if res.Err != nil {
+				if cfg.errorSink != nil {
+					cfg.errorSink(res.Err)
+				}
+				continue
+			}
+
+			if !send(ctx, outChan, res.Value) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Notification represents a stream event as a value: either an emitted value or the
+// completion of the stream, in which case Completed is true and Value is the zero value.
+type Notification[T any] struct {
+	Value     T
+	Completed bool
+}
+
+// Materialize converts each value from the input channel into a Notification and, when
+// the input closes, emits a final notification with Completed set. This makes stream
+// completion observable as a regular value, e.g. for debugging or logging.
+// If the context is cancelled, the output closes without a completion notification.
+//
+// Examples:
+//
+//	Materialize(ctx, ch)   // [1, 2] -> [{1 false}, {2 false}, {0 true}]
+func Materialize[T any](ctx context.Context, in <-chan T) <-chan Notification[T] {
+	outChan := make(chan Notification[T])
+
+	go func() {
+		defer close(outChan)
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				if ctx.Err() == nil {
+					send(ctx, outChan, Notification[T]{Completed: true})
+				}
+				return
+			}
+
+			if !send(ctx, outChan, Notification[T]{Value: val}) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Dematerialize reverses Materialize, forwarding the values of notifications and closing
+// the output at the first completion notification. Any notifications after completion
+// are drained and discarded.
+// The output channel closes on completion, when the input closes, or context is cancelled.
+//
+// Examples:
+//
+//	Dematerialize(ctx, Materialize(ctx, ch))   // round-trips the original stream
+func Dematerialize[T any](ctx context.Context, in <-chan Notification[T]) <-chan T {
+	outChan := make(chan T)
+
+	go func() {
+		defer close(outChan)
+		for {
+			n, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if n.Completed {
+				go drain(in)
+				return
+			}
+
+			if !send(ctx, outChan, n.Value) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Chunk groups values from the input channel into slices of 'size' values.
+// Unlike Batch, there is no timeout: a chunk is emitted only once it is full, except for
+// the final partial chunk, which is emitted when the input closes.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Chunk(ctx, ch, 3)   // [1, 2, 3, 4, 5, 6, 7] -> [[1, 2, 3], [4, 5, 6], [7]]
+func Chunk[T any](ctx context.Context, in <-chan T, size int, opts ...ChanOption[[]T]) <-chan []T {
+	outChan := applyChanOptions(opts...)
+	size = max(size, 1)
+
+	go func() {
+		defer close(outChan)
+		chunk := make([]T, 0, size)
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				if len(chunk) > 0 && ctx.Err() == nil {
+					send(ctx, outChan, chunk)
+				}
+				return
+			}
+
+			chunk = append(chunk, val)
+			if len(chunk) == size {
+				if !send(ctx, outChan, chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Pairwise emits each value paired with the value that preceded it, so a stream of
+// N values yields N-1 pairs. The first value only seeds the first pair.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Pairwise(ctx, ch)    // [1, 2, 3, 4] -> {1, 2}, {2, 3}, {3, 4}
+func Pairwise[T any](ctx context.Context, in <-chan T, opts ...ChanOption[struct {
+	Prev T
+	Curr T
+}]) <-chan struct {
+	Prev T
+	Curr T
+} {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		prev, ok := recieve(ctx, in)
+		if !ok {
+			return
+		}
+
+		for {
+			curr, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			pair := struct {
+				Prev T
+				Curr T
+			}{prev, curr}
+			if !send(ctx, outChan, pair) {
+				return
+			}
+			prev = curr
+		}
+	}()
+
+	return outChan
+}
+
+// Delta emits the difference between each value and the one before it (curr - prev).
+// The first value produces no output, so a stream of N values yields N-1 deltas.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Delta(ctx, ch)             // [10, 13, 13, 20] -> [3, 0, 7]
+//	Delta(ctx, counterReads)   // per-sample increase of a monotonic counter
+func Delta[T Numeric](ctx context.Context, in <-chan T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for pair := range Pairwise(ctx, in) {
+			if !send(ctx, outChan, pair.Curr-pair.Prev) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Enumerate pairs each value with its zero-based position in the stream.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Enumerate(ctx, ch)    // ["a", "b"] -> {0, "a"}, {1, "b"}
+func Enumerate[T any](ctx context.Context, in <-chan T, opts ...ChanOption[struct {
+	Index int
+	Value T
+}]) <-chan struct {
+	Index int
+	Value T
+} {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for index := 0; ; index++ {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			item := struct {
+				Index int
+				Value T
+			}{index, val}
+			if !send(ctx, outChan, item) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// SplitFields splits each input string on sep and emits every field as a separate value, in order.
+// Splitting follows strings.Split, so empty fields are kept. Context cancellation is checked
+// between field emissions. The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	SplitFields(ctx, lines, ",")    // ["a,b", "c"] -> ["a", "b", "c"]
+func SplitFields(ctx context.Context, in <-chan string, sep string, opts ...ChanOption[string]) <-chan string {
+	return splitEach(ctx, in, func(s string) []string { return strings.Split(s, sep) }, opts...)
+}
+
+// SplitRegexp splits each input string around matches of re and emits every field as a
+// separate value, in order. Splitting follows re.Split with no limit.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	SplitRegexp(ctx, lines, regexp.MustCompile(`\s+`))    // ["a  b", "c"] -> ["a", "b", "c"]
+func SplitRegexp(ctx context.Context, in <-chan string, re *regexp.Regexp, opts ...ChanOption[string]) <-chan string {
+	return splitEach(ctx, in, func(s string) []string { return re.Split(s, -1) }, opts...)
+}
+
+// splitEach emits the pieces produced by split for every input string.
+func splitEach(ctx context.Context, in <-chan string, split func(string) []string, opts ...ChanOption[string]) <-chan string {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for {
+			line, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			for _, field := range split(line) {
+				if !send(ctx, outChan, field) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Join concatenates all strings from the input channel, separated by sep.
+// It is the channel analog of strings.Join. This is a blocking operation that returns
+// when the channel closes; if the context is cancelled, the partial join is returned.
+//
+// Examples:
+//
+//	Join(ctx, ch, "-")     // ["a", "b", "c"] -> "a-b-c"
+//	Join(ctx, lines, "\n")
+func Join(ctx context.Context, in <-chan string, sep string) string {
+	var b strings.Builder
+	first := true
+	for {
+		s, ok := recieve(ctx, in)
+		if !ok {
+			return b.String()
+		}
+
+		if !first {
+			b.WriteString(sep)
+		}
+		b.WriteString(s)
+		first = false
+	}
+}
+
+// MaxBy returns the value whose key, as derived by keyFn, is the largest. On ties the
+// first such value wins. It returns false if no value was received. This is a blocking
+// operation; if the context is cancelled, the best value seen so far is returned.
+//
+// Examples:
+//
+//	MaxBy(ctx, words, func(s string) int { return len(s) })        // longest word
+//	MaxBy(ctx, orders, func(o Order) float64 { return o.Total })   // largest order
+func MaxBy[T any, K cmp.Ordered](ctx context.Context, in <-chan T, keyFn func(T) K) (T, bool) {
+	return extremeBy(ctx, in, keyFn, func(candidate, best K) bool { return candidate > best })
+}
+
+// MinBy returns the value whose key, as derived by keyFn, is the smallest. On ties the
+// first such value wins. It returns false if no value was received. This is a blocking
+// operation; if the context is cancelled, the best value seen so far is returned.
+//
+// Examples:
+//
+//	MinBy(ctx, words, func(s string) int { return len(s) })            // shortest word
+//	MinBy(ctx, tasks, func(t Task) int64 { return t.Deadline.Unix() })    // most urgent task
+func MinBy[T any, K cmp.Ordered](ctx context.Context, in <-chan T, keyFn func(T) K) (T, bool) {
+	return extremeBy(ctx, in, keyFn, func(candidate, best K) bool { return candidate < best })
+}
+
+// extremeBy returns the first value whose key is not beaten by any later key according to better.
+func extremeBy[T any, K cmp.Ordered](ctx context.Context, in <-chan T, keyFn func(T) K, better func(candidate, best K) bool) (T, bool) {
+	best, ok := recieve(ctx, in)
+	if !ok {
+		return best, false
+	}
+	bestKey := keyFn(best)
+
+	for {
+		val, ok := recieve(ctx, in)
+		if !ok {
+			return best, true
+		}
+
+		if key := keyFn(val); better(key, bestKey) {
+			best, bestKey = val, key
+		}
+	}
+}
+
+// Windows collects the whole finite stream and returns every full window of 'size' values,
+// starting a new window every 'step' values. Trailing values that cannot fill a window are
+// not included. This is a blocking operation; if the context is cancelled, the windows over
+// the values received so far are returned.
+//
+// The whole stream is held in memory. The windows are capped sub-slices of one backing array,
+// so overlapping windows share storage instead of copying it. Appending to a window is safe,
+// but writing to its elements is visible in the windows that overlap it.
+//
+// Examples:
+//
+//	Windows(ctx, ch, 2, 1)    // [1, 2, 3, 4] -> [[1, 2], [2, 3], [3, 4]]
+//	Windows(ctx, ch, 2, 2)    // [1, 2, 3, 4, 5] -> [[1, 2], [3, 4]]
+func Windows[T any](ctx context.Context, in <-chan T, size, step int) [][]T {
+	if size <= 0 {
+		go drain(in)
+		return nil
+	}
+	step = max(step, 1)
+
+	values := ChanToSlice(ctx, in)
+
+	var windows [][]T
+	for start := 0; start+size <= len(values); start += step {
+		windows = append(windows, values[start:start+size:start+size])
+	}
+	return windows
+}
+
+// Transitions emits a {From, To} pair each time a value differs from the one before it.
+// Repeated values produce nothing, and the first value only establishes the starting state.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	Transitions(ctx, ch)        // [1, 1, 2, 2, 3] -> {1, 2}, {2, 3}
+//	Transitions(ctx, states)    // ["idle", "busy", "busy", "idle"] -> {idle, busy}, {busy, idle}
+func Transitions[T comparable](ctx context.Context, in <-chan T, opts ...ChanOption[struct {
+	From T
+	To   T
+}]) <-chan struct {
+	From T
+	To   T
+} {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for pair := range Pairwise(ctx, in) {
+			if pair.Prev == pair.Curr {
+				continue
+			}
+
+			transition := struct {
+				From T
+				To   T
+			}{pair.Prev, pair.Curr}
+			if !send(ctx, outChan, transition) {
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// MapBatch applies fn to each incoming batch and emits the resulting batch. This suits
+// transforms that are cheaper in bulk, and composes with Batch upstream and FlattenSlices downstream.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	MapBatch(ctx, batches, func(xs []int) []int { ... })             // [[1, 2], [3]] -> [[2, 4], [6]]
+//	FlattenSlices(ctx, MapBatch(ctx, Batch(ctx, rows, 100, time.Second), bulkEnrich))
+func MapBatch[T, R any](ctx context.Context, in <-chan []T, fn func([]T) []R, opts ...ChanOption[[]R]) <-chan []R {
+	return Map(ctx, in, fn, opts...)
+}
+
+// FlattenSlices emits every element of each incoming slice, in order.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Examples:
+//
+//	FlattenSlices(ctx, batches)    // [[1, 2], [], [3]] -> [1, 2, 3]
+func FlattenSlices[T any](ctx context.Context, in <-chan []T, opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for {
+			batch, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			for _, val := range batch {
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
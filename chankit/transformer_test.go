@@ -2,6 +2,12 @@ package chankit
 
 import (
 	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -376,7 +382,140 @@ func TestFilter(t *testing.T) {
 	})
 }
 
+// TestFilterErr tests the FilterErr function
+func TestFilterErr(t *testing.T) {
+	t.Run("filters without error", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+
+		out, errFn := FilterErr(ctx, inChan, func(x int) (bool, error) {
+			return x%2 == 0, nil
+		})
+
+		var result []int
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []int{2, 4, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if err := errFn(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+
+	t.Run("stops on predicate error", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+		errBoom := errors.New("boom")
+
+		out, errFn := FilterErr(ctx, inChan, func(x int) (bool, error) {
+			if x == 4 {
+				return false, errBoom
+			}
+			return x%2 == 0, nil
+		})
+
+		var result []int
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []int{2}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if err := errFn(); !errors.Is(err, errBoom) {
+			t.Errorf("expected %v, got %v", errBoom, err)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out, errFn := FilterErr(ctx, in, func(int) (bool, error) { return true, nil })
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+		if err := errFn(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
 // TestReduce tests the Reduce function
+func TestFilterParallel(t *testing.T) {
+	t.Run("preserves input order", func(t *testing.T) {
+		ctx := context.Background()
+		input := make([]int, 100)
+		for i := range input {
+			input[i] = i
+		}
+
+		out := FilterParallel(ctx, SliceToChan(ctx, input), 4, func(x int) bool {
+			// Later values finish first to force reordering.
+			time.Sleep(time.Duration(10-x%10) * 100 * time.Microsecond)
+			return x%3 == 0
+		})
+
+		var result []int
+		for val := range out {
+			result = append(result, val)
+		}
+
+		var expected []int
+		for _, x := range input {
+			if x%3 == 0 {
+				expected = append(expected, x)
+			}
+		}
+
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		ctx := context.Background()
+		out := FilterParallel(ctx, SliceToChan(ctx, []int{}), 4, func(int) bool { return true })
+
+		if result := ChanToSlice(ctx, out); len(result) != 0 {
+			t.Errorf("expected empty result, got %v", result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := FilterParallel(ctx, Repeat(ctx, 1), 4, func(int) bool { return true })
+
+		<-out
+		cancel()
+
+		done := make(chan struct{})
+		go func() {
+			for range out {
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
 func TestReduce(t *testing.T) {
 	t.Run("basic reduce - sum", func(t *testing.T) {
 		ctx := context.Background()
@@ -530,6 +669,376 @@ func TestReduce(t *testing.T) {
 	})
 }
 
+// TestReduceCheckpoint tests the ReduceCheckpoint function
+func TestReduceCheckpoint(t *testing.T) {
+	sum := func(acc, val int) int { return acc + val }
+
+	t.Run("checkpoints at the right counts", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7})
+
+		var checkpoints []int
+		result := ReduceCheckpoint(ctx, inChan, sum, 0, 3, func(acc int) {
+			checkpoints = append(checkpoints, acc)
+		})
+
+		if result != 28 {
+			t.Errorf("expected 28, got %d", result)
+		}
+		expected := []int{6, 21}
+		if !reflect.DeepEqual(checkpoints, expected) {
+			t.Errorf("expected checkpoints %v, got %v", expected, checkpoints)
+		}
+	})
+
+	t.Run("non-positive interval disables checkpoints", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3})
+
+		called := false
+		result := ReduceCheckpoint(ctx, inChan, sum, 0, 0, func(int) { called = true })
+
+		if result != 6 {
+			t.Errorf("expected 6, got %d", result)
+		}
+		if called {
+			t.Error("expected no checkpoints")
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		inChan := make(chan int)
+
+		go func() {
+			inChan <- 1
+			inChan <- 2
+			cancel()
+		}()
+
+		result := ReduceCheckpoint(ctx, inChan, sum, 0, 1, func(int) {})
+		if result != 3 {
+			t.Errorf("expected partial result 3, got %d", result)
+		}
+	})
+}
+
+// TestReduceParallel tests the ReduceParallel function
+func TestReduceParallel(t *testing.T) {
+	sum := func(a, b int) int { return a + b }
+
+	t.Run("parallel sum matches sequential", func(t *testing.T) {
+		ctx := context.Background()
+		input := make([]int, 1000)
+		for i := range input {
+			input[i] = i + 1
+		}
+
+		for _, workers := range []int{1, 4, 16} {
+			in := SliceToChan(ctx, input, WithBufferAuto[int]())
+			result := ReduceParallel(ctx, in, workers, sum, 0)
+			if result != 500500 {
+				t.Errorf("workers=%d: expected 500500, got %d", workers, result)
+			}
+		}
+	})
+
+	t.Run("empty input returns identity", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{})
+
+		result := ReduceParallel(ctx, in, 4, func(a, b int) int { return a * b }, 1)
+		if result != 1 {
+			t.Errorf("expected 1, got %d", result)
+		}
+	})
+
+	t.Run("context cancellation returns partial", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+
+		go func() {
+			in <- 1
+			in <- 2
+			cancel()
+		}()
+
+		result := ReduceParallel(ctx, in, 2, sum, 0)
+		if result != 3 {
+			t.Errorf("expected partial sum 3, got %d", result)
+		}
+	})
+}
+
+// TestReduceWhile tests the ReduceWhile function
+func TestReduceWhile(t *testing.T) {
+	sum := func(acc, x int) int { return acc + x }
+	overTen := func(acc int) bool { return acc > 10 }
+
+	t.Run("stops once the accumulator exceeds the threshold", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6, 7})
+
+		result, stopped := ReduceWhile(ctx, in, sum, 0, overTen)
+		if !stopped {
+			t.Error("expected early stop")
+		}
+		if result != 15 {
+			t.Errorf("expected 15, got %d", result)
+		}
+	})
+
+	t.Run("runs to completion when threshold is never reached", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3})
+
+		result, stopped := ReduceWhile(ctx, in, sum, 0, overTen)
+		if stopped {
+			t.Error("expected no early stop")
+		}
+		if result != 6 {
+			t.Errorf("expected 6, got %d", result)
+		}
+	})
+
+	t.Run("drains remaining input after stopping", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan int)
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			defer close(in)
+			for i := 1; i <= 100; i++ {
+				in <- i
+			}
+		}()
+
+		if _, stopped := ReduceWhile(ctx, in, sum, 0, overTen); !stopped {
+			t.Error("expected early stop")
+		}
+
+		select {
+		case <-done:
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("producer blocked after early stop")
+		}
+	})
+}
+
+// TestReduceByKey tests the ReduceByKey function
+func TestReduceByKey(t *testing.T) {
+	parity := func(x int) string {
+		if x%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	t.Run("sums values grouped by parity", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+
+		result := ReduceByKey(ctx, inChan, parity, func(acc, val int) int { return acc + val }, 0)
+
+		expected := map[string]int{"even": 12, "odd": 9}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("initial value seeds each key", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3})
+
+		result := ReduceByKey(ctx, inChan, parity, func(acc []int, val int) []int { return append(acc, val) }, []int{0})
+
+		expected := map[string][]int{"even": {0, 2}, "odd": {0, 1, 3}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation returns partial map", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		inChan := make(chan int)
+
+		go func() {
+			inChan <- 1
+			inChan <- 2
+			cancel()
+		}()
+
+		result := ReduceByKey(ctx, inChan, parity, func(acc, val int) int { return acc + val }, 0)
+
+		expected := map[string]int{"even": 2, "odd": 1}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+// TestFrequencies tests the Frequencies function
+func TestFrequencies(t *testing.T) {
+	t.Run("counts occurrences", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []string{"a", "b", "a", "c", "a"})
+
+		result := Frequencies(ctx, inChan)
+
+		expected := map[string]int{"a": 3, "b": 1, "c": 1}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		ctx := context.Background()
+
+		if result := Frequencies(ctx, SliceToChan(ctx, []string{})); len(result) != 0 {
+			t.Errorf("expected empty map, got %v", result)
+		}
+	})
+
+	t.Run("context cancellation returns partial histogram", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		inChan := make(chan string)
+
+		go func() {
+			inChan <- "a"
+			inChan <- "a"
+			cancel()
+		}()
+
+		result := Frequencies(ctx, inChan)
+
+		expected := map[string]int{"a": 2}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+}
+
+// TestAccumulate tests the Accumulate function
+func TestAccumulate(t *testing.T) {
+	t.Run("prefix sums", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3})
+
+		result := Accumulate(ctx, inChan, func(acc, val int) int { return acc + val }, 0)
+
+		expected := []int{1, 3, 6}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("accumulator type differs from input", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []string{"a", "b", "c"})
+
+		result := Accumulate(ctx, inChan, func(acc string, val string) string { return acc + val }, ">")
+
+		expected := []string{">a", ">ab", ">abc"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("empty channel returns no states", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := make(chan int)
+		close(inChan)
+
+		result := Accumulate(ctx, inChan, func(acc, val int) int { return acc + val }, 0)
+
+		if len(result) != 0 {
+			t.Errorf("expected no states, got %v", result)
+		}
+	})
+
+	t.Run("context cancellation returns partial states", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		inChan := make(chan int)
+		go func() {
+			defer close(inChan)
+			for i := 1; i <= 100; i++ {
+				select {
+				case inChan <- i:
+					time.Sleep(10 * time.Millisecond)
+				case <-time.After(time.Second):
+					return
+				}
+			}
+		}()
+
+		result := Accumulate(ctx, inChan, func(acc, val int) int { return acc + val }, 0)
+
+		if len(result) == 0 || len(result) >= 100 {
+			t.Errorf("expected partial states, got %d", len(result))
+		}
+	})
+}
+
+// TestMapAccum tests the MapAccum function
+func TestMapAccum(t *testing.T) {
+	t.Run("emits running indices with values", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []string{"a", "b", "c"})
+
+		out := MapAccum(ctx, inChan, 0, func(i int, s string) (int, string) {
+			return i + 1, strconv.Itoa(i) + ":" + s
+		})
+
+		var result []string
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []string{"0:a", "1:b", "2:c"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("state threads through every call", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 4})
+
+		out := MapAccum(ctx, inChan, 0, func(sum, x int) (int, bool) {
+			return sum + x, sum+x > 5
+		})
+
+		var result []bool
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []bool{false, false, true, true}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := MapAccum(ctx, make(chan int), 0, func(s, x int) (int, int) { return s, x })
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
 // TestMapFilterReduce tests combining Map, Filter, and Reduce
 func TestMapFilterReduce(t *testing.T) {
 	t.Run("map then filter", func(t *testing.T) {
@@ -585,3 +1094,1103 @@ func TestMapFilterReduce(t *testing.T) {
 		}
 	})
 }
+
+// TestDistinctBy tests the DistinctBy function
+func TestDistinctBy(t *testing.T) {
+	t.Run("keeps first value per key", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []string{"Go", "rust", "GO", "Rust", "zig"})
+
+		var result []string
+		for val := range DistinctBy(ctx, inChan, strings.ToLower) {
+			result = append(result, val)
+		}
+
+		expected := []string{"Go", "rust", "zig"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan string)
+		out := DistinctBy(ctx, in, strings.ToLower)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestDistinctLastN tests the DistinctLastN function
+func TestDistinctLastN(t *testing.T) {
+	t.Run("suppresses values within the window", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 1, 2, 3, 3})
+
+		var result []int
+		for val := range DistinctLastN(ctx, inChan, 3) {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("re-emits a value after n others have passed", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 3, 1, 4, 1})
+
+		var result []int
+		for val := range DistinctLastN(ctx, inChan, 2) {
+			result = append(result, val)
+		}
+
+		// 1 is evicted once 2 and 3 have been emitted, so it passes again;
+		// the final 1 is suppressed because it is still within the window [1, 4].
+		expected := []int{1, 2, 3, 1, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("zero window passes everything", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 1, 1})
+
+		var result []int
+		for val := range DistinctLastN(ctx, inChan, 0) {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 1, 1}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := DistinctLastN(ctx, in, 5)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestDistinctWindow(t *testing.T) {
+	t.Run("suppresses repeats within the ttl", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2, 1, 2, 3, 1})
+
+		var result []int
+		for val := range DistinctWindow(ctx, inChan, time.Second) {
+			result = append(result, val)
+		}
+
+		expected := []int{1, 2, 3}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("re-emits a value once the ttl has passed", func(t *testing.T) {
+		ctx := context.Background()
+		in := make(chan string)
+		out := DistinctWindow(ctx, in, 50*time.Millisecond)
+
+		go func() {
+			defer close(in)
+			in <- "a"
+			in <- "a"
+			time.Sleep(80 * time.Millisecond)
+			in <- "a"
+		}()
+
+		var result []string
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []string{"a", "a"}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := DistinctWindow(ctx, make(chan int), time.Second)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestRoute tests the Route function
+func TestRoute(t *testing.T) {
+	sign := func(x int) string {
+		switch {
+		case x < 0:
+			return "neg"
+		case x == 0:
+			return "zero"
+		default:
+			return "pos"
+		}
+	}
+
+	collect := func(outs map[string]<-chan int) map[string][]int {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		results := make(map[string][]int)
+
+		for key, ch := range outs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for val := range ch {
+					mu.Lock()
+					results[key] = append(results[key], val)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		return results
+	}
+
+	t.Run("routes values into labeled buckets", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{-2, 0, 3, -1, 5, 0})
+
+		outs := Route(ctx, inChan, sign, []string{"neg", "zero", "pos"})
+		if len(outs) != 3 {
+			t.Fatalf("expected 3 output channels, got %d", len(outs))
+		}
+
+		result := collect(outs)
+		expected := map[string][]int{
+			"neg":  {-2, -1},
+			"zero": {0, 0},
+			"pos":  {3, 5},
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("drops values with unknown keys", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{-2, 0, 3, -1, 5})
+
+		result := collect(Route(ctx, inChan, sign, []string{"pos"}))
+
+		expected := map[string][]int{"pos": {3, 5}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation closes all outputs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		outs := Route(ctx, in, sign, []string{"neg", "zero", "pos"})
+
+		cancel()
+
+		for key, ch := range outs {
+			select {
+			case _, ok := <-ch:
+				if ok {
+					t.Errorf("expected %q channel to be closed", key)
+				}
+			case <-time.After(100 * time.Millisecond):
+				t.Fatalf("%q channel did not close after cancellation", key)
+			}
+		}
+	})
+}
+
+// TestGroupBy tests the GroupBy function
+func TestGroupBy(t *testing.T) {
+	t.Run("groups values by key in first-seen order", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"apple", "bob", "avocado", "cat", "banana"})
+		groups := GroupBy(ctx, in, func(s string) byte { return s[0] })
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		var keys []byte
+		collected := make(map[byte][]string)
+
+		for g := range groups {
+			keys = append(keys, g.Key)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range g.Values {
+					mu.Lock()
+					collected[g.Key] = append(collected[g.Key], v)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		if string(keys) != "abc" {
+			t.Errorf("expected keys %q, got %q", "abc", string(keys))
+		}
+		expected := map[byte][]string{
+			'a': {"apple", "avocado"},
+			'b': {"bob", "banana"},
+			'c': {"cat"},
+		}
+		if !reflect.DeepEqual(collected, expected) {
+			t.Errorf("expected %v, got %v", expected, collected)
+		}
+	})
+
+	t.Run("buffered groups can be read sequentially", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+		groups := GroupBy(ctx, in, func(x int) bool { return x%2 == 0 }, WithBuffer[int](6))
+
+		var collected []<-chan int
+		for g := range groups {
+			collected = append(collected, g.Values)
+		}
+
+		var odds, evens []int
+		for v := range collected[0] {
+			odds = append(odds, v)
+		}
+		for v := range collected[1] {
+			evens = append(evens, v)
+		}
+
+		if !reflect.DeepEqual(odds, []int{1, 3, 5}) || !reflect.DeepEqual(evens, []int{2, 4, 6}) {
+			t.Errorf("expected [1 3 5] and [2 4 6], got %v and %v", odds, evens)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := GroupBy(ctx, make(chan int), func(x int) int { return x })
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestIntersperse tests the Intersperse function
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		expected []int
+	}{
+		{"multiple values", []int{1, 2, 3}, []int{1, 0, 2, 0, 3}},
+		{"single value", []int{1}, []int{1}},
+		{"empty input", []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			inChan := SliceToChan(ctx, tt.input)
+
+			var result []int
+			for val := range Intersperse(ctx, inChan, 0) {
+				result = append(result, val)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Intersperse(ctx, in, 0)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSkipErrors tests the SkipErrors function
+func TestSkipErrors(t *testing.T) {
+	errOdd := errors.New("odd value")
+	input := []Result[int]{
+		{Value: 1, Err: errOdd},
+		{Value: 2},
+		{Value: 3, Err: errOdd},
+		{Value: 4},
+	}
+
+	t.Run("forwards only successful values", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, input)
+
+		var result []int
+		for val := range SkipErrors(ctx, inChan) {
+			result = append(result, val)
+		}
+
+		expected := []int{2, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("error sink observes dropped errors", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, input)
+
+		var dropped []error
+		out := SkipErrors(ctx, inChan, WithErrorSink[int](func(err error) {
+			dropped = append(dropped, err)
+		}))
+
+		var result []int
+		for val := range out {
+			result = append(result, val)
+		}
+
+		expected := []int{2, 4}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+		if len(dropped) != 2 {
+			t.Fatalf("expected 2 dropped errors, got %d", len(dropped))
+		}
+		for _, err := range dropped {
+			if !errors.Is(err, errOdd) {
+				t.Errorf("expected %v, got %v", errOdd, err)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan Result[int])
+		out := SkipErrors(ctx, in)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestMaterialize tests the Materialize and Dematerialize functions
+func TestMaterialize(t *testing.T) {
+	t.Run("emits values then completion", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []int{1, 2})
+
+		var result []Notification[int]
+		for n := range Materialize(ctx, inChan) {
+			result = append(result, n)
+		}
+
+		expected := []Notification[int]{{Value: 1}, {Value: 2}, {Completed: true}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		ctx := context.Background()
+		input := []int{1, 2, 3, 4}
+		inChan := SliceToChan(ctx, input)
+
+		var result []int
+		for val := range Dematerialize(ctx, Materialize(ctx, inChan)) {
+			result = append(result, val)
+		}
+
+		if !reflect.DeepEqual(result, input) {
+			t.Errorf("expected %v, got %v", input, result)
+		}
+	})
+
+	t.Run("dematerialize stops at completion", func(t *testing.T) {
+		ctx := context.Background()
+		inChan := SliceToChan(ctx, []Notification[int]{{Value: 1}, {Completed: true}, {Value: 2}})
+
+		var result []int
+		for val := range Dematerialize(ctx, inChan) {
+			result = append(result, val)
+		}
+
+		expected := []int{1}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("cancellation emits no completion", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Materialize(ctx, in)
+
+		cancel()
+
+		select {
+		case n, ok := <-out:
+			if ok {
+				t.Errorf("expected channel to be closed, got %v", n)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestChunk tests the Chunk function
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		expected [][]int
+	}{
+		{"with partial final chunk", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{"exact multiple", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"size larger than input", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty input", []int{}, 3, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			inChan := SliceToChan(ctx, tt.input)
+
+			var result [][]int
+			for chunk := range Chunk(ctx, inChan, tt.size) {
+				result = append(result, chunk)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Chunk(ctx, in, 3)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestPairwise tests the Pairwise function
+func TestPairwise(t *testing.T) {
+	type pair = struct {
+		Prev int
+		Curr int
+	}
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []pair
+	}{
+		{"adjacent pairs", []int{1, 2, 3, 4}, []pair{{1, 2}, {2, 3}, {3, 4}}},
+		{"single value yields nothing", []int{1}, nil},
+		{"empty input", []int{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var result []pair
+			for p := range Pairwise(ctx, SliceToChan(ctx, tt.input)) {
+				result = append(result, p)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Pairwise(ctx, in)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestDelta tests the Delta function
+func TestDelta(t *testing.T) {
+	t.Run("differences between consecutive values", func(t *testing.T) {
+		ctx := context.Background()
+
+		var result []int
+		for v := range Delta(ctx, SliceToChan(ctx, []int{10, 13, 13, 20})) {
+			result = append(result, v)
+		}
+
+		expected := []int{3, 0, 7}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("floats and negative deltas", func(t *testing.T) {
+		ctx := context.Background()
+
+		var result []float64
+		for v := range Delta(ctx, SliceToChan(ctx, []float64{1.5, 1.0, 3.0})) {
+			result = append(result, v)
+		}
+
+		expected := []float64{-0.5, 2.0}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := Delta(ctx, in)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestEnumerate tests the Enumerate function
+func TestEnumerate(t *testing.T) {
+	type indexed = struct {
+		Index int
+		Value string
+	}
+
+	t.Run("pairs values with positions", func(t *testing.T) {
+		ctx := context.Background()
+
+		var result []indexed
+		for item := range Enumerate(ctx, SliceToChan(ctx, []string{"a", "b"})) {
+			result = append(result, item)
+		}
+
+		expected := []indexed{{0, "a"}, {1, "b"}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan string)
+		out := Enumerate(ctx, in)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestSplitFields tests the SplitFields function
+func TestSplitFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		sep      string
+		expected []string
+	}{
+		{"splits each line", []string{"a,b", "c"}, ",", []string{"a", "b", "c"}},
+		{"keeps empty fields", []string{"a,,b"}, ",", []string{"a", "", "b"}},
+		{"multi-char separator", []string{"x::y"}, "::", []string{"x", "y"}},
+		{"empty input", []string{}, ",", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var result []string
+			for field := range SplitFields(ctx, SliceToChan(ctx, tt.input), tt.sep) {
+				result = append(result, field)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation between fields", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := SplitFields(ctx, SliceToChan(ctx, []string{"a,b,c,d,e"}), ",")
+
+		<-out
+		cancel()
+
+		timeout := time.After(100 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-out:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+// TestSplitRegexp tests the SplitRegexp function
+func TestSplitRegexp(t *testing.T) {
+	ctx := context.Background()
+	re := regexp.MustCompile(`\s+`)
+
+	var result []string
+	for field := range SplitRegexp(ctx, SliceToChan(ctx, []string{"a  b", "c\td"}), re) {
+		result = append(result, field)
+	}
+
+	expected := []string{"a", "b", "c", "d"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %q, got %q", expected, result)
+	}
+}
+
+// TestJoin tests the Join function
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []string
+		sep      string
+		expected string
+	}{
+		{"joins with separator", []string{"a", "b", "c"}, "-", "a-b-c"},
+		{"single value", []string{"a"}, "-", "a"},
+		{"empty input", []string{}, "-", ""},
+		{"empty separator", []string{"a", "b"}, "", "ab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			result := Join(ctx, SliceToChan(ctx, tt.input), tt.sep)
+			if result != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation returns partial join", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan string)
+
+		go func() {
+			in <- "a"
+			in <- "b"
+			cancel()
+		}()
+
+		result := Join(ctx, in, ",")
+		if result != "a,b" {
+			t.Errorf("expected %q, got %q", "a,b", result)
+		}
+	})
+}
+
+// TestMaxBy tests the MaxBy function
+func TestMaxBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	t.Run("finds the longest string", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"go", "channel", "kit", "pipeline", "fan"})
+
+		result, ok := MaxBy(ctx, in, length)
+		if !ok || result != "pipeline" {
+			t.Errorf("expected (pipeline, true), got (%s, %v)", result, ok)
+		}
+	})
+
+	t.Run("first value wins ties", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"ab", "cd", "e"})
+
+		result, _ := MaxBy(ctx, in, length)
+		if result != "ab" {
+			t.Errorf("expected ab, got %s", result)
+		}
+	})
+
+	t.Run("empty stream", func(t *testing.T) {
+		ctx := context.Background()
+
+		if _, ok := MaxBy(ctx, SliceToChan(ctx, []string{}), length); ok {
+			t.Error("expected false for empty stream")
+		}
+	})
+}
+
+// TestMinBy tests the MinBy function
+func TestMinBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+
+	t.Run("finds the shortest string", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []string{"channel", "go", "kit", "a", "fan"})
+
+		result, ok := MinBy(ctx, in, length)
+		if !ok || result != "a" {
+			t.Errorf("expected (a, true), got (%s, %v)", result, ok)
+		}
+	})
+
+	t.Run("context cancellation returns best so far", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan string)
+
+		go func() {
+			in <- "long"
+			in <- "mid"
+			cancel()
+		}()
+
+		result, ok := MinBy(ctx, in, length)
+		if !ok || result != "mid" {
+			t.Errorf("expected (mid, true), got (%s, %v)", result, ok)
+		}
+	})
+}
+
+// TestWindows tests the Windows function
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{"sliding by one", []int{1, 2, 3, 4}, 2, 1, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"tumbling", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"step larger than size", []int{1, 2, 3, 4, 5, 6}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{"size larger than input", []int{1, 2}, 3, 1, nil},
+		{"invalid size", []int{1, 2}, 0, 1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			result := Windows(ctx, SliceToChan(ctx, tt.input), tt.size, tt.step)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("appending to a window does not clobber its neighbour", func(t *testing.T) {
+		ctx := context.Background()
+
+		windows := Windows(ctx, SliceToChan(ctx, []int{1, 2, 3}), 2, 1)
+		_ = append(windows[0], 99)
+
+		if !reflect.DeepEqual(windows[1], []int{2, 3}) {
+			t.Errorf("expected [2 3], got %v", windows[1])
+		}
+	})
+}
+
+// TestTransitions tests the Transitions function
+func TestTransitions(t *testing.T) {
+	type transition = struct {
+		From int
+		To   int
+	}
+
+	tests := []struct {
+		name     string
+		input    []int
+		expected []transition
+	}{
+		{"skips repeats", []int{1, 1, 2, 2, 3}, []transition{{1, 2}, {2, 3}}},
+		{"returning to a previous value", []int{1, 2, 1}, []transition{{1, 2}, {2, 1}}},
+		{"no changes", []int{5, 5, 5}, nil},
+		{"single value", []int{1}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var result []transition
+			for tr := range Transitions(ctx, SliceToChan(ctx, tt.input)) {
+				result = append(result, tr)
+			}
+
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Transitions(ctx, make(chan int))
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestMapBatch tests the MapBatch function
+func TestMapBatch(t *testing.T) {
+	double := func(xs []int) []int {
+		out := make([]int, len(xs))
+		for i, x := range xs {
+			out[i] = x * 2
+		}
+		return out
+	}
+
+	t.Run("doubles every element of each batch", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, [][]int{{1, 2}, {3}, {}})
+
+		var result [][]int
+		for batch := range MapBatch(ctx, in, double) {
+			result = append(result, batch)
+		}
+
+		expected := [][]int{{2, 4}, {6}, {}}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("composes with Chunk and FlattenSlices", func(t *testing.T) {
+		ctx := context.Background()
+		in := SliceToChan(ctx, []int{1, 2, 3, 4, 5})
+
+		var result []int
+		for v := range FlattenSlices(ctx, MapBatch(ctx, Chunk(ctx, in, 2), double)) {
+			result = append(result, v)
+		}
+
+		expected := []int{2, 4, 6, 8, 10}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected, result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := MapBatch(ctx, make(chan []int), double)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestFlattenSlices tests the FlattenSlices function
+func TestFlattenSlices(t *testing.T) {
+	ctx := context.Background()
+	in := SliceToChan(ctx, [][]string{{"a", "b"}, nil, {"c"}})
+
+	var result []string
+	for v := range FlattenSlices(ctx, in) {
+		result = append(result, v)
+	}
+
+	expected := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+// Benchmark tests
+func expensiveSum(a, b int) int {
+	// Simulate a CPU-bound combine step.
+	x := a + b
+	for i := 0; i < 1000; i++ {
+		x = (x*31 + i) % 1000003
+	}
+	if x < 0 {
+		return x
+	}
+	return a + b
+}
+
+func BenchmarkReduce_Expensive(b *testing.B) {
+	ctx := context.Background()
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		in := SliceToChan(ctx, input, WithBufferAuto[int]())
+		_ = Reduce(ctx, in, expensiveSum, 0)
+	}
+}
+
+func BenchmarkReduceParallel_Expensive(b *testing.B) {
+	ctx := context.Background()
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		in := SliceToChan(ctx, input, WithBufferAuto[int]())
+		_ = ReduceParallel(ctx, in, 4, expensiveSum, 0)
+	}
+}
+
+func slowIsEven(x int) bool {
+	time.Sleep(100 * time.Microsecond)
+	return x%2 == 0
+}
+
+func BenchmarkFilter_Slow(b *testing.B) {
+	ctx := context.Background()
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		in := SliceToChan(ctx, input, WithBufferAuto[int]())
+		for range Filter(ctx, in, slowIsEven) {
+		}
+	}
+}
+
+func BenchmarkFilterParallel_Slow(b *testing.B) {
+	ctx := context.Background()
+	input := make([]int, 100)
+	for i := range input {
+		input[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		in := SliceToChan(ctx, input, WithBufferAuto[int]())
+		for range FilterParallel(ctx, in, 8, slowIsEven) {
+		}
+	}
+}
@@ -2,7 +2,9 @@ package chankit
 
 import (
 	"context"
+	"slices"
 	"sync"
+	"time"
 )
 
 // Tap creates a channel that passes through all values from the input channel,
@@ -49,6 +51,24 @@ func Tap[T any](ctx context.Context, in <-chan T, tapFunc func(T), opts ...ChanO
 	return outChan
 }
 
+// TapIndexed passes through all values like Tap, but also gives tapFunc the zero-based
+// position of each value. This is handy for progress logging without an external counter.
+//
+// Example:
+//
+//	output := TapIndexed(ctx, input, func(i int, _ Record) {
+//		if i%1000 == 0 {
+//			log.Printf("processing item %d", i)
+//		}
+//	})
+func TapIndexed[T any](ctx context.Context, in <-chan T, tapFunc func(i int, v T), opts ...ChanOption[T]) <-chan T {
+	index := 0
+	return Tap(ctx, in, func(v T) {
+		tapFunc(index, v)
+		index++
+	}, opts...)
+}
+
 // FlatMap transforms each value from the input channel into a channel of values using flatMapFunc,
 // then flattens all resulting channels into a single output channel. This is useful for operations
 // where each input value needs to be expanded into multiple output values concurrently.
@@ -107,3 +127,372 @@ func FlatMap[T, R any](ctx context.Context, in <-chan T, flatMapFunc func(T) <-c
 
 	return outChan
 }
+
+// Meter passes all values through unchanged while periodically reporting throughput.
+// Every interval it calls report with the number of values seen since the previous
+// report and the time elapsed since then, then resets the counter. When the input
+// closes, a final report covers any values seen since the last one, so the reported
+// counts always sum to the total number of values.
+//
+// Example:
+//
+//	output := Meter(ctx, input, time.Second, func(count int, d time.Duration) {
+//		log.Printf("%.1f items/sec", float64(count)/d.Seconds())
+//	})
+func Meter[T any](ctx context.Context, in <-chan T, every time.Duration, report func(count int, d time.Duration), opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		ticker := time.NewTicker(every)
+		defer ticker.Stop()
+
+		count := 0
+		last := time.Now()
+
+		flush := func(now time.Time) {
+			report(count, now.Sub(last))
+			count = 0
+			last = now
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case now := <-ticker.C:
+				flush(now)
+
+			case val, ok := <-in:
+				if !ok {
+					if count > 0 {
+						flush(time.Now())
+					}
+					return
+				}
+
+				count++
+				if !send(ctx, outChan, val) {
+					return
+				}
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// Rebuffer forwards all values unchanged through a new channel with the given buffer size.
+// This decouples upstream and downstream pacing at an explicit point in a chain, regardless
+// of how the input channel was buffered. A size of zero produces an unbuffered channel.
+// The output channel closes when the input closes or context is cancelled.
+//
+// Example:
+//
+//	fast := Rebuffer(ctx, slowProducer, 1000)  // absorb bursts ahead of a slow consumer
+func Rebuffer[T any](ctx context.Context, in <-chan T, size int) <-chan T {
+	outChan := make(chan T, max(size, 0))
+
+	go func() {
+		defer close(outChan)
+		forwardSimple(ctx, outChan, in)
+	}()
+
+	return outChan
+}
+
+// Flatten merges a channel of channels into a single output channel.
+// Each inner channel is drained in its own goroutine, so values from different inner
+// channels may interleave. Use WithConcurrency to bound how many inner channels are
+// drained simultaneously; additional inner channels wait until a slot frees up.
+// The output channel closes once the outer channel and all inner channels have closed,
+// or the context is cancelled.
+//
+// Example:
+//
+//	output := Flatten(ctx, channels)                             // unbounded
+//	output := Flatten(ctx, channels, WithConcurrency[int](4))    // at most 4 inner channels at once
+func Flatten[T any](ctx context.Context, in <-chan (<-chan T), opts ...ChanOption[T]) <-chan T {
+	cfg := &chanConfig[T]{bufferSize: 0}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	outChan := applyChanOptions(opts...)
+
+	var slots chan struct{}
+	if cfg.concurrency > 0 {
+		slots = make(chan struct{}, cfg.concurrency)
+	}
+
+	go func() {
+		var wg sync.WaitGroup
+
+		defer func() {
+			wg.Wait()
+			close(outChan)
+		}()
+
+		for {
+			innerChan, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if slots != nil && !send(ctx, slots, struct{}{}) {
+				go drain(innerChan)
+				return
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if slots != nil {
+					defer func() { <-slots }()
+				}
+				forwardSimple(ctx, outChan, innerChan)
+			}()
+		}
+	}()
+
+	return outChan
+}
+
+// FlattenRoundRobin merges a channel of channels by taking one value from each inner
+// channel in turn, so a busy inner channel cannot crowd out the others. Inner channels that
+// are already waiting on the outer channel join the rotation before each take; later ones are
+// accepted as they arrive. Strict rotation means a slow inner channel paces the whole output.
+// Closed inner channels drop out of the rotation. The output channel closes once the outer
+// channel and all inner channels have closed, or the context is cancelled.
+//
+// Example:
+//
+//	Inner: [a1, a2, a3], [b1, b2, b3], [s1, s2] (slow)
+//	Output: [a1, b1, s1, a2, b2, s2, a3, b3]
+func FlattenRoundRobin[T any](ctx context.Context, in <-chan (<-chan T), opts ...ChanOption[T]) <-chan T {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		var active []<-chan T
+		next := 0
+		outer := in
+
+		for {
+		register:
+			for outer != nil {
+				select {
+				case innerChan, ok := <-outer:
+					if !ok {
+						outer = nil
+						break register
+					}
+					active = append(active, innerChan)
+				default:
+					break register
+				}
+			}
+
+			if len(active) == 0 {
+				if outer == nil {
+					return
+				}
+				innerChan, ok := recieve(ctx, outer)
+				if !ok {
+					outer = nil
+					continue
+				}
+				active = append(active, innerChan)
+			}
+
+			next %= len(active)
+			select {
+			case <-ctx.Done():
+				return
+
+			case innerChan, ok := <-outer:
+				if !ok {
+					outer = nil
+					continue
+				}
+				active = append(active, innerChan)
+
+			case val, ok := <-active[next]:
+				if !ok {
+					active = slices.Delete(active, next, next+1)
+					continue
+				}
+				if !send(ctx, outChan, val) {
+					return
+				}
+				next++
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// MergeMap maps each value from the input channel to an inner channel and merges the
+// inner channels into the output, draining at most 'concurrency' of them at a time.
+// The mapping function is only invoked once a slot is free, so no more than
+// 'concurrency' inner streams are ever started concurrently. Output order across inner
+// channels is not guaranteed.
+//
+// Unlike FlatMap, which starts an unbounded number of inner streams, MergeMap applies
+// backpressure to the input once all slots are busy. Unlike ConcatMap, which drains
+// inner streams one at a time to preserve input order, MergeMap interleaves them.
+//
+// Example:
+//
+//	// Fetch pages with at most 4 requests in flight
+//	output := MergeMap(ctx, urls, 4, func(url string) <-chan Page {
+//		return fetchPages(ctx, url)
+//	})
+func MergeMap[T, R any](ctx context.Context, in <-chan T, concurrency int, fn func(T) <-chan R, opts ...ChanOption[R]) <-chan R {
+	outChan := applyChanOptions(opts...)
+	slots := make(chan struct{}, max(concurrency, 1))
+
+	go func() {
+		var wg sync.WaitGroup
+
+		defer func() {
+			wg.Wait()
+			close(outChan)
+		}()
+
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, slots, struct{}{}) {
+				go drain(in)
+				return
+			}
+
+			innerChan := fn(val)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer func() { <-slots }()
+				forwardSimple(ctx, outChan, innerChan)
+			}()
+		}
+	}()
+
+	return outChan
+}
+
+// ConcatMap maps each value from the input channel to an inner channel and forwards the
+// inner channels one after another. The next input value is not mapped until the current
+// inner channel has closed, so output order follows input order.
+//
+// Example:
+//
+//	// Read files sequentially, emitting their lines in file order
+//	lines := ConcatMap(ctx, paths, func(path string) <-chan string {
+//		return readLines(ctx, path)
+//	})
+func ConcatMap[T, R any](ctx context.Context, in <-chan T, fn func(T) <-chan R, opts ...ChanOption[R]) <-chan R {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+		for {
+			val, ok := recieve(ctx, in)
+			if !ok {
+				return
+			}
+
+			forwardSimple(ctx, outChan, fn(val))
+			if ctx.Err() != nil {
+				go drain(in)
+				return
+			}
+		}
+	}()
+
+	return outChan
+}
+
+// SwitchMap maps each value from the input channel to an inner channel, forwarding values
+// only from the most recent one. When a new input value arrives, the previous inner
+// channel is abandoned (drained in the background) along with any of its values not yet
+// delivered, and forwarding switches to the new inner channel. This suits "latest wins"
+// scenarios such as autocomplete, where results for outdated queries are irrelevant.
+// The output channel closes once the input and the current inner channel have closed,
+// or the context is cancelled.
+//
+// Example:
+//
+//	results := SwitchMap(ctx, queries, func(q string) <-chan Result {
+//		return search(ctx, q)
+//	})
+func SwitchMap[T, R any](ctx context.Context, in <-chan T, fn func(T) <-chan R, opts ...ChanOption[R]) <-chan R {
+	outChan := applyChanOptions(opts...)
+
+	go func() {
+		defer close(outChan)
+
+		var current <-chan R
+		var pending *R
+
+		defer func() {
+			if current != nil {
+				go drain(current)
+			}
+		}()
+
+		for {
+			if in == nil && current == nil && pending == nil {
+				return
+			}
+
+			// While a value is pending, stop reading the inner channel and offer the
+			// value downstream instead; a nil channel disables its select case.
+			var outCh chan<- R
+			var innerCh <-chan R
+			var next R
+			if pending != nil {
+				outCh = outChan
+				next = *pending
+			} else {
+				innerCh = current
+			}
+
+			select {
+			case <-ctx.Done():
+				if in != nil {
+					go drain(in)
+				}
+				return
+
+			case val, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				if current != nil {
+					go drain(current)
+				}
+				current = fn(val)
+				pending = nil
+
+			case val, ok := <-innerCh:
+				if !ok {
+					current = nil
+					continue
+				}
+				pending = &val
+
+			case outCh <- next:
+				pending = nil
+			}
+		}
+	}()
+
+	return outChan
+}
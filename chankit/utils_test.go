@@ -169,6 +169,56 @@ func TestTap(t *testing.T) {
 }
 
 // TestFlatMap tests the FlatMap function
+func TestTapIndexed(t *testing.T) {
+	t.Run("passes increasing indexes and values through", func(t *testing.T) {
+		ctx := context.Background()
+		input := []string{"a", "b", "c", "d"}
+
+		var indexes []int
+		var seen []string
+		out := TapIndexed(ctx, SliceToChan(ctx, input), func(i int, v string) {
+			indexes = append(indexes, i)
+			seen = append(seen, v)
+		})
+
+		var result []string
+		for val := range out {
+			result = append(result, val)
+		}
+
+		if len(result) != len(input) {
+			t.Fatalf("expected %d values, got %d", len(input), len(result))
+		}
+		for i, v := range result {
+			if v != input[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, input[i], v)
+			}
+			if indexes[i] != i {
+				t.Errorf("expected tap index %d, got %d", i, indexes[i])
+			}
+			if seen[i] != input[i] {
+				t.Errorf("at index %d: tap saw %s, expected %s", i, seen[i], input[i])
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := TapIndexed(ctx, make(chan int), func(int, int) {})
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
 func TestFlatMap(t *testing.T) {
 	t.Run("basic flatmap", func(t *testing.T) {
 		ctx := context.Background()
@@ -418,3 +468,544 @@ func TestFlatMap(t *testing.T) {
 		}
 	})
 }
+
+// TestMeter tests the Meter function
+func TestMeter(t *testing.T) {
+	t.Run("report counts sum to the total", func(t *testing.T) {
+		ctx := context.Background()
+		input := make(chan int)
+
+		var mu sync.Mutex
+		var counts []int
+
+		output := Meter(ctx, input, 20*time.Millisecond, func(count int, d time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+			counts = append(counts, count)
+			if d <= 0 {
+				t.Errorf("expected positive interval, got %v", d)
+			}
+		})
+
+		go func() {
+			defer close(input)
+			for i := 1; i <= 50; i++ {
+				input <- i
+				time.Sleep(2 * time.Millisecond)
+			}
+		}()
+
+		var results []int
+		for val := range output {
+			results = append(results, val)
+		}
+
+		if len(results) != 50 {
+			t.Fatalf("expected 50 values to pass through, got %d", len(results))
+		}
+		for i, v := range results {
+			if v != i+1 {
+				t.Errorf("at index %d: expected %d, got %d", i, i+1, v)
+			}
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if len(counts) < 2 {
+			t.Errorf("expected multiple reports, got %d", len(counts))
+		}
+		total := 0
+		for _, c := range counts {
+			total += c
+		}
+		if total != 50 {
+			t.Errorf("expected report counts to sum to 50, got %d (%v)", total, counts)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan int)
+		output := Meter(ctx, input, 10*time.Millisecond, func(int, time.Duration) {})
+
+		cancel()
+
+		select {
+		case _, ok := <-output:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+// TestFlatten tests the Flatten function
+func TestRebuffer(t *testing.T) {
+	t.Run("preserves order and uses the requested capacity", func(t *testing.T) {
+		ctx := context.Background()
+		input := []int{1, 2, 3, 4, 5}
+
+		out := Rebuffer(ctx, SliceToChan(ctx, input), 3)
+		if cap(out) != 3 {
+			t.Errorf("expected cap 3, got %d", cap(out))
+		}
+
+		var result []int
+		for val := range out {
+			result = append(result, val)
+		}
+
+		if len(result) != len(input) {
+			t.Fatalf("expected %d values, got %d", len(input), len(result))
+		}
+		for i, v := range result {
+			if v != input[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, input[i], v)
+			}
+		}
+	})
+
+	t.Run("zero size is unbuffered", func(t *testing.T) {
+		ctx := context.Background()
+
+		if out := Rebuffer(ctx, SliceToChan(ctx, []int{1}), 0); cap(out) != 0 {
+			t.Errorf("expected cap 0, got %d", cap(out))
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := Rebuffer(ctx, make(chan int), 2)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	makeInner := func(values ...int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			defer close(ch)
+			for _, v := range values {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	t.Run("flattens all inner channels", func(t *testing.T) {
+		ctx := context.Background()
+		outer := make(chan (<-chan int), 3)
+		outer <- makeInner(1, 2)
+		outer <- makeInner(3)
+		outer <- makeInner(4, 5, 6)
+		close(outer)
+
+		seen := make(map[int]bool)
+		for val := range Flatten(ctx, outer) {
+			seen[val] = true
+		}
+
+		for i := 1; i <= 6; i++ {
+			if !seen[i] {
+				t.Errorf("missing value %d", i)
+			}
+		}
+	})
+
+	t.Run("respects concurrency limit", func(t *testing.T) {
+		ctx := context.Background()
+		const limit = 2
+
+		var active, maxActive int32
+		var mu sync.Mutex
+		outer := make(chan (<-chan int))
+
+		go func() {
+			defer close(outer)
+			for i := 0; i < 6; i++ {
+				ch := make(chan int)
+				outer <- ch
+				go func(n int) {
+					defer close(ch)
+
+					// The send only completes once Flatten is draining this channel.
+					ch <- n
+					mu.Lock()
+					active++
+					if active > maxActive {
+						maxActive = active
+					}
+					mu.Unlock()
+
+					time.Sleep(20 * time.Millisecond)
+
+					mu.Lock()
+					active--
+					mu.Unlock()
+				}(i)
+			}
+		}()
+
+		count := 0
+		for range Flatten(ctx, outer, WithConcurrency[int](limit)) {
+			count++
+		}
+
+		if count != 6 {
+			t.Errorf("expected 6 values, got %d", count)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if maxActive > limit {
+			t.Errorf("expected at most %d active inner channels, observed %d", limit, maxActive)
+		}
+	})
+
+	t.Run("context cancellation while waiting for a slot", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		outer := make(chan (<-chan int), 2)
+		blocked := make(chan int)
+		outer <- blocked
+		outer <- makeInner(1)
+
+		out := Flatten(ctx, outer, WithConcurrency[int](1))
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+
+		timeout := time.After(200 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-out:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+// TestMergeMap tests the MergeMap function
+func TestFlattenRoundRobin(t *testing.T) {
+	t.Run("interleaves fast and slow inner channels fairly", func(t *testing.T) {
+		ctx := context.Background()
+
+		slow := make(chan string)
+		go func() {
+			defer close(slow)
+			for _, v := range []string{"s1", "s2"} {
+				time.Sleep(20 * time.Millisecond)
+				slow <- v
+			}
+		}()
+
+		outer := make(chan (<-chan string), 3)
+		outer <- SliceToChan(ctx, []string{"a1", "a2", "a3"})
+		outer <- SliceToChan(ctx, []string{"b1", "b2", "b3"})
+		outer <- slow
+		close(outer)
+
+		var result []string
+		for val := range FlattenRoundRobin(ctx, outer) {
+			result = append(result, val)
+		}
+
+		expected := []string{"a1", "b1", "s1", "a2", "b2", "s2", "a3", "b3"}
+		if len(result) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, result)
+		}
+		for i, v := range result {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %s, got %s", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("accepts inner channels that arrive later", func(t *testing.T) {
+		ctx := context.Background()
+		outer := make(chan (<-chan int))
+
+		go func() {
+			defer close(outer)
+			outer <- SliceToChan(ctx, []int{1, 2})
+			time.Sleep(10 * time.Millisecond)
+			outer <- SliceToChan(ctx, []int{3, 4})
+		}()
+
+		var result []int
+		for val := range FlattenRoundRobin(ctx, outer) {
+			result = append(result, val)
+		}
+
+		if len(result) != 4 {
+			t.Fatalf("expected 4 values, got %v", result)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		outer := make(chan (<-chan int), 1)
+		outer <- make(chan int)
+		out := FlattenRoundRobin(ctx, outer)
+
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("expected channel to be closed")
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("channel did not close after cancellation")
+		}
+	})
+}
+
+func TestMergeMap(t *testing.T) {
+	t.Run("emits all values with bounded concurrency", func(t *testing.T) {
+		ctx := context.Background()
+		const limit = 2
+
+		var mu sync.Mutex
+		var active, maxActive int
+
+		input := SliceToChan(ctx, []int{1, 2, 3, 4, 5, 6})
+		output := MergeMap(ctx, input, limit, func(n int) <-chan int {
+			mu.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mu.Unlock()
+
+			ch := make(chan int)
+			go func() {
+				defer close(ch)
+				ch <- n
+				ch <- n * 10
+				time.Sleep(10 * time.Millisecond)
+				mu.Lock()
+				active--
+				mu.Unlock()
+			}()
+			return ch
+		})
+
+		seen := make(map[int]bool)
+		for val := range output {
+			seen[val] = true
+		}
+
+		for i := 1; i <= 6; i++ {
+			if !seen[i] || !seen[i*10] {
+				t.Errorf("missing values for %d", i)
+			}
+		}
+		if len(seen) != 12 {
+			t.Errorf("expected 12 distinct values, got %d", len(seen))
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if maxActive > limit {
+			t.Errorf("expected at most %d concurrent inner streams, observed %d", limit, maxActive)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan int)
+		output := MergeMap(ctx, input, 2, func(n int) <-chan int {
+			return Repeat(ctx, n)
+		})
+
+		input <- 1
+		<-output
+		cancel()
+
+		timeout := time.After(200 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-output:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+// TestConcatMap tests the ConcatMap function
+func TestConcatMap(t *testing.T) {
+	t.Run("preserves input order", func(t *testing.T) {
+		ctx := context.Background()
+		input := SliceToChan(ctx, []int{3, 1, 2})
+
+		output := ConcatMap(ctx, input, func(n int) <-chan int {
+			ch := make(chan int)
+			go func() {
+				defer close(ch)
+				// Slower inner streams must still not be overtaken
+				time.Sleep(time.Duration(n) * 5 * time.Millisecond)
+				ch <- n
+				ch <- n * 10
+			}()
+			return ch
+		})
+
+		var results []int
+		for val := range output {
+			results = append(results, val)
+		}
+
+		expected := []int{3, 30, 1, 10, 2, 20}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan int)
+		output := ConcatMap(ctx, input, func(n int) <-chan int {
+			return Repeat(ctx, n)
+		})
+
+		input <- 1
+		<-output
+		cancel()
+
+		timeout := time.After(200 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-output:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}
+
+// TestSwitchMap tests the SwitchMap function
+func TestSwitchMap(t *testing.T) {
+	t.Run("only the latest inner stream reaches the output", func(t *testing.T) {
+		ctx := context.Background()
+		input := make(chan int)
+
+		output := SwitchMap(ctx, input, func(n int) <-chan int {
+			ch := make(chan int)
+			go func() {
+				defer close(ch)
+				for j := 0; j < 3; j++ {
+					time.Sleep(20 * time.Millisecond)
+					select {
+					case ch <- n*10 + j:
+					case <-time.After(time.Second):
+						return
+					}
+				}
+			}()
+			return ch
+		})
+
+		go func() {
+			defer close(input)
+			input <- 1
+			input <- 2
+			input <- 3
+		}()
+
+		var results []int
+		for val := range output {
+			results = append(results, val)
+		}
+
+		expected := []int{30, 31, 32}
+		if len(results) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, results)
+		}
+		for i, v := range results {
+			if v != expected[i] {
+				t.Errorf("at index %d: expected %d, got %d", i, expected[i], v)
+			}
+		}
+	})
+
+	t.Run("switches mid-stream", func(t *testing.T) {
+		ctx := context.Background()
+		input := make(chan string)
+		first := make(chan string)
+		second := make(chan string, 1)
+		second <- "b1"
+		close(second)
+
+		inner := map[string]chan string{"a": first, "b": second}
+		output := SwitchMap(ctx, input, func(key string) <-chan string { return inner[key] })
+
+		input <- "a"
+		first <- "a1"
+		if val := <-output; val != "a1" {
+			t.Errorf("expected a1, got %s", val)
+		}
+
+		input <- "b"
+		close(input)
+
+		var results []string
+		for val := range output {
+			results = append(results, val)
+		}
+		if len(results) != 1 || results[0] != "b1" {
+			t.Errorf("expected [b1], got %v", results)
+		}
+		close(first)
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		input := make(chan int)
+		output := SwitchMap(ctx, input, func(n int) <-chan int {
+			return Repeat(ctx, n)
+		})
+
+		input <- 1
+		<-output
+		cancel()
+
+		timeout := time.After(200 * time.Millisecond)
+		for {
+			select {
+			case _, ok := <-output:
+				if !ok {
+					return
+				}
+			case <-timeout:
+				t.Fatal("channel did not close after cancellation")
+			}
+		}
+	})
+}